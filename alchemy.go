@@ -1,7 +1,7 @@
 // Package alchemy provides a Golang SDK for the Alchemy API.
 //
 // The SDK covers Node API (JSON-RPC), Data API (Transfers, NFTs, Tokens),
-// and Wallet API for blockchain data access.
+// Wallet API and Prices API for blockchain data access.
 //
 // Example usage:
 //
@@ -24,15 +24,23 @@
 package alchemy
 
 import (
+	"context"
+
 	"github.com/ABT-Tech-Limited/alchemy-go/client"
 	"github.com/ABT-Tech-Limited/alchemy-go/data"
+	"github.com/ABT-Tech-Limited/alchemy-go/errors"
 	"github.com/ABT-Tech-Limited/alchemy-go/node"
+	"github.com/ABT-Tech-Limited/alchemy-go/prices"
 	"github.com/ABT-Tech-Limited/alchemy-go/wallet"
 )
 
 // Alchemy is the main client for the Alchemy API.
+// It is safe for concurrent use by multiple goroutines: a single client can
+// be shared process-wide. Requests started after Close fail with
+// errors.ErrClientClosed.
 type Alchemy struct {
 	config *Config
+	http   *client.HTTPClient
 
 	// Node provides access to JSON-RPC methods (eth_*, debug_*, etc.).
 	Node *node.Client
@@ -42,6 +50,9 @@ type Alchemy struct {
 
 	// Wallet provides high-level wallet operations.
 	Wallet *wallet.Client
+
+	// Prices provides access to the token Prices API.
+	Prices *prices.Client
 }
 
 // New creates a new Alchemy client with the given configuration.
@@ -56,29 +67,38 @@ func New(cfg Config) (*Alchemy, error) {
 
 	// Create HTTP client
 	httpClient := client.NewHTTPClient(client.HTTPClientConfig{
-		BaseURL:       cfg.GetBaseURL(),
-		APIKey:        cfg.APIKey,
-		Timeout:       cfg.Timeout,
-		MaxRetries:    cfg.MaxRetries,
-		RetryDelay:    cfg.RetryDelay,
-		RetryMaxDelay: cfg.RetryMaxDelay,
-		HTTPClient:    cfg.HTTPClient,
-		Debug:         cfg.Debug,
+		BaseURL:        cfg.GetBaseURL(),
+		APIKey:         cfg.APIKey,
+		Timeout:        cfg.Timeout,
+		MaxRetries:     cfg.MaxRetries,
+		RetryDelay:     cfg.RetryDelay,
+		RetryMaxDelay:  cfg.RetryMaxDelay,
+		HTTPClient:     cfg.HTTPClient,
+		Transport:      cfg.Transport,
+		Debug:          cfg.Debug,
+		StrictDecoding: cfg.StrictDecoding,
 	})
 
 	// Create JSON-RPC client
 	rpcClient := client.NewJSONRPCClient(httpClient)
+	rpcClient.SetDeduplicate(cfg.Deduplicate)
 
 	// Create sub-clients
-	nodeClient := node.NewClient(rpcClient)
-	dataClient := data.NewClient(httpClient, rpcClient, cfg.Network.NFTURL())
+	nodeClient := node.NewClient(rpcClient).WithBeaconURL(cfg.GetBeaconURL(), cfg.APIKey)
+	dataClient := data.NewClientWithAPIKey(httpClient, rpcClient, cfg.Network.NFTURL(), cfg.APIKey).
+		WithPortfolioURL(cfg.PortfolioURL).
+		WithNativeCurrency(data.NativeCurrency{Symbol: cfg.Network.NativeCurrency(), Decimals: cfg.Network.NativeDecimals()}).
+		WithAddressLabeler(cfg.AddressLabeler)
 	walletClient := wallet.NewClient(dataClient, nodeClient)
+	pricesClient := prices.NewClient(httpClient, cfg.PricesURL, cfg.APIKey)
 
 	return &Alchemy{
 		config: &cfg,
+		http:   httpClient,
 		Node:   nodeClient,
 		Data:   dataClient,
 		Wallet: walletClient,
+		Prices: pricesClient,
 	}, nil
 }
 
@@ -91,6 +111,15 @@ func (a *Alchemy) WithNetwork(network Network) (*Alchemy, error) {
 	return New(cfg)
 }
 
+// Close releases the client's resources: idle HTTP connections are closed
+// and subsequent requests fail with errors.ErrClientClosed. Requests already
+// in flight complete normally. Clients created with WithNetwork are
+// independent and must be closed separately. Close is safe to call more
+// than once.
+func (a *Alchemy) Close() error {
+	return a.http.Close()
+}
+
 // Network returns the current network.
 func (a *Alchemy) Network() Network {
 	return a.config.Network
@@ -100,3 +129,21 @@ func (a *Alchemy) Network() Network {
 func (a *Alchemy) Config() Config {
 	return *a.config
 }
+
+// Ping checks that the endpoint is reachable, the API key is accepted, and
+// the endpoint serves the configured network. It makes a single eth_chainId
+// call, so it is cheap enough for readiness probes.
+// A chain ID that differs from Network().ChainID() is reported as an
+// *errors.ChainIDMismatchError. The check is skipped for unknown networks.
+func (a *Alchemy) Ping(ctx context.Context) error {
+	chainID, err := a.Node.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	expected := a.config.Network.ChainID()
+	if expected != 0 && chainID != expected {
+		return errors.NewChainIDMismatchError(a.config.Network.String(), expected, chainID)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package alchemy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// TestAlchemyConcurrentUse hammers a single client from many goroutines.
+// Run with -race to check the concurrency guarantees documented on Alchemy.
+func TestAlchemyConcurrentUse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req client.JSONRPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "eth_chainId":
+			resp["result"] = "0x1"
+		case "eth_blockNumber":
+			resp["result"] = "0x10"
+		case "alchemy_getTokenMetadata":
+			resp["result"] = map[string]interface{}{"name": "Token " + req.Params[0].(string), "decimals": 6}
+		default:
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	for _, dedupe := range []bool{false, true} {
+		t.Run(fmt.Sprintf("deduplicate=%v", dedupe), func(t *testing.T) {
+			a, err := New(Config{
+				APIKey:      "test-key",
+				Network:     EthMainnet,
+				BaseURL:     srv.URL,
+				Deduplicate: dedupe,
+			})
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			defer a.Close()
+
+			ctx := context.Background()
+			var wg sync.WaitGroup
+			for i := 0; i < 100; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					switch i % 3 {
+					case 0:
+						if n, err := a.Node.BlockNumber(ctx); err != nil || n != 16 {
+							t.Errorf("BlockNumber() = %d, %v; want 16", n, err)
+						}
+					case 1:
+						if err := a.Ping(ctx); err != nil {
+							t.Errorf("Ping() error = %v", err)
+						}
+					case 2:
+						contract := types.MustParseAddress(fmt.Sprintf("0x%040x", i%5))
+						metadata, err := a.Data.GetTokenMetadata(ctx, contract)
+						if err != nil {
+							t.Errorf("GetTokenMetadata() error = %v", err)
+							return
+						}
+						if want := "Token " + contract.String(); metadata.Name == nil || *metadata.Name != want {
+							t.Errorf("GetTokenMetadata() name = %v, want %q", metadata.Name, want)
+						}
+					}
+				}(i)
+			}
+			wg.Wait()
+		})
+	}
+}
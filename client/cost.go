@@ -0,0 +1,200 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+// DefaultComputeUnitCost is charged for methods missing from the cost table.
+const DefaultComputeUnitCost = 26
+
+// DefaultComputeUnitCosts is the published compute unit cost per method.
+// JSON-RPC methods are keyed by method name and REST endpoints by the last
+// path segment (e.g. "getNFTsForOwner").
+var DefaultComputeUnitCosts = map[string]uint64{
+	"eth_blockNumber":                      10,
+	"eth_chainId":                          0,
+	"net_version":                          0,
+	"eth_syncing":                          0,
+	"eth_gasPrice":                         19,
+	"eth_maxPriorityFeePerGas":             10,
+	"eth_blobBaseFee":                      10,
+	"eth_feeHistory":                       10,
+	"eth_getBalance":                       19,
+	"eth_getCode":                          19,
+	"eth_getStorageAt":                     17,
+	"eth_getTransactionCount":              26,
+	"eth_getBlockByNumber":                 16,
+	"eth_getBlockByHash":                   21,
+	"eth_getBlockTransactionCountByHash":   20,
+	"eth_getBlockTransactionCountByNumber": 20,
+	"eth_getTransactionByHash":             17,
+	"eth_getTransactionReceipt":            15,
+	"eth_getBlockReceipts":                 500,
+	"eth_getLogs":                          75,
+	"eth_call":                             26,
+	"eth_estimateGas":                      87,
+	"eth_sendRawTransaction":               250,
+	"eth_getProof":                         21,
+	"eth_newFilter":                        20,
+	"eth_newBlockFilter":                   20,
+	"eth_newPendingTransactionFilter":      20,
+	"eth_getFilterChanges":                 20,
+	"eth_getFilterLogs":                    75,
+	"eth_uninstallFilter":                  10,
+	"alchemy_getAssetTransfers":            150,
+	"alchemy_getTokenBalances":             19,
+	"alchemy_getTokenMetadata":             10,
+	"alchemy_getTokenAllowance":            19,
+	"getNFTsForOwner":                      480,
+	"getNFTMetadata":                       80,
+	"getNFTMetadataBatch":                  500,
+	"getContractMetadata":                  10,
+	"getContractMetadataBatch":             50,
+	"getNFTsForContract":                   600,
+	"getOwnersForNFT":                      10,
+	"getOwnersForContract":                 350,
+	"getContractsForOwner":                 350,
+	"getSpamContracts":                     100,
+	"isSpamContract":                       10,
+	"reportSpam":                           10,
+	"invalidateContract":                   100,
+}
+
+// CostMiddleware estimates the compute units (CU) spent by each request and
+// optionally enforces a budget. Requests that would push the total over the
+// budget fail with errors.ErrBudgetExceeded without being sent.
+// Costs are charged before the request is sent, so failed and retried
+// requests count toward the total. It is safe for concurrent use.
+type CostMiddleware struct {
+	mu          sync.Mutex
+	costs       map[string]uint64
+	defaultCost uint64
+	budget      uint64
+	spent       uint64
+}
+
+// NewCostMiddleware creates a CostMiddleware seeded with DefaultComputeUnitCosts.
+// A budget of 0 disables enforcement.
+func NewCostMiddleware(budget uint64) *CostMiddleware {
+	costs := make(map[string]uint64, len(DefaultComputeUnitCosts))
+	for method, cost := range DefaultComputeUnitCosts {
+		costs[method] = cost
+	}
+	return &CostMiddleware{
+		costs:       costs,
+		defaultCost: DefaultComputeUnitCost,
+		budget:      budget,
+	}
+}
+
+// SetCost overrides the cost of a method.
+func (m *CostMiddleware) SetCost(method string, cu uint64) *CostMiddleware {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.costs[method] = cu
+	return m
+}
+
+// SetDefaultCost sets the cost charged for methods missing from the table.
+func (m *CostMiddleware) SetDefaultCost(cu uint64) *CostMiddleware {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.defaultCost = cu
+	return m
+}
+
+// SetBudget sets the CU budget. A budget of 0 disables enforcement.
+func (m *CostMiddleware) SetBudget(budget uint64) *CostMiddleware {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.budget = budget
+	return m
+}
+
+// SpentCU returns the estimated compute units spent so far.
+func (m *CostMiddleware) SpentCU() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.spent
+}
+
+// Reset sets the spent compute units back to zero.
+func (m *CostMiddleware) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.spent = 0
+}
+
+// Wrap implements Middleware.
+func (m *CostMiddleware) Wrap(next Handler) Handler {
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		methods, err := requestMethods(req)
+		if err != nil {
+			return nil, err
+		}
+
+		m.mu.Lock()
+		var cost uint64
+		for _, method := range methods {
+			if c, ok := m.costs[method]; ok {
+				cost += c
+			} else {
+				cost += m.defaultCost
+			}
+		}
+		if m.budget > 0 && m.spent+cost > m.budget {
+			spent, budget := m.spent, m.budget
+			m.mu.Unlock()
+			return nil, fmt.Errorf("%w: request costs %d CU, %d of %d CU spent", errors.ErrBudgetExceeded, cost, spent, budget)
+		}
+		m.spent += cost
+		m.mu.Unlock()
+
+		return next(ctx, req)
+	}
+}
+
+// requestMethods returns the API methods invoked by a request: the JSON-RPC
+// method names in the body, or the last path segment for REST requests.
+// The request body is restored after reading.
+func requestMethods(req *http.Request) ([]string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return []string{path.Base(req.URL.Path)}, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "READ_ERROR", "failed to read request body")
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	type rpcMethod struct {
+		Method string `json:"method"`
+	}
+
+	var batch []rpcMethod
+	if err := json.Unmarshal(body, &batch); err == nil {
+		methods := make([]string, len(batch))
+		for i, call := range batch {
+			methods[i] = call.Method
+		}
+		return methods, nil
+	}
+
+	var single rpcMethod
+	if err := json.Unmarshal(body, &single); err == nil && single.Method != "" {
+		return []string{single.Method}, nil
+	}
+
+	return []string{path.Base(req.URL.Path)}, nil
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// dedupeMethods lists the read-only methods that may share a request.
+// Methods that create or change node state, such as eth_newFilter or
+// eth_sendRawTransaction, must reach the node once per caller.
+var dedupeMethods = map[string]bool{
+	"eth_blobBaseFee":                         true,
+	"eth_blockNumber":                         true,
+	"eth_call":                                true,
+	"eth_chainId":                             true,
+	"eth_createAccessList":                    true,
+	"eth_estimateGas":                         true,
+	"eth_feeHistory":                          true,
+	"eth_gasPrice":                            true,
+	"eth_getBalance":                          true,
+	"eth_getBlockByHash":                      true,
+	"eth_getBlockByNumber":                    true,
+	"eth_getBlockReceipts":                    true,
+	"eth_getBlockTransactionCountByHash":      true,
+	"eth_getBlockTransactionCountByNumber":    true,
+	"eth_getCode":                             true,
+	"eth_getLogs":                             true,
+	"eth_getProof":                            true,
+	"eth_getStorageAt":                        true,
+	"eth_getTransactionByBlockHashAndIndex":   true,
+	"eth_getTransactionByBlockNumberAndIndex": true,
+	"eth_getTransactionByHash":                true,
+	"eth_getTransactionCount":                 true,
+	"eth_getTransactionReceipt":               true,
+	"eth_maxPriorityFeePerGas":                true,
+	"eth_syncing":                             true,
+	"net_version":                             true,
+	"alchemy_getAssetTransfers":               true,
+	"alchemy_getTokenAllowance":               true,
+	"alchemy_getTokenBalances":                true,
+	"alchemy_getTokenMetadata":                true,
+	"alchemy_getTokensForOwner":               true,
+	"alchemy_getTransactionReceipts":          true,
+}
+
+// callGroup shares the result of identical in-flight JSON-RPC calls.
+type callGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+}
+
+// inflightCall is a JSON-RPC call shared by one or more callers.
+type inflightCall struct {
+	done     chan struct{}
+	cancel   context.CancelFunc
+	deadline time.Time // zero if the call has no deadline
+	waiters  int
+	resp     *JSONRPCResponse
+	err      error
+}
+
+// SetDeduplicate enables or disables request deduplication. When enabled,
+// concurrent calls with the same method and params share a single upstream
+// request and its response. Only single calls to read-only methods are
+// deduplicated; batches and state-changing methods are always sent.
+// It must be called before the client is used concurrently.
+func (c *JSONRPCClient) SetDeduplicate(enabled bool) {
+	if !enabled {
+		c.dedupe = nil
+		return
+	}
+	if c.dedupe == nil {
+		c.dedupe = &callGroup{calls: make(map[string]*inflightCall)}
+	}
+}
+
+// do runs fn once for all concurrent callers with the same method and params.
+// The shared request runs on a context detached from the callers' contexts,
+// keeping their values and the deadline of the caller that started it, and
+// is cancelled when every caller has stopped waiting. Each caller stops
+// waiting when its own context is done. A caller whose deadline is earlier
+// than the shared request's sends its own request, so a shared request never
+// outlives the earliest deadline of its callers.
+func (g *callGroup) do(ctx context.Context, method string, params []interface{}, fn func(context.Context, string, []interface{}) (*JSONRPCResponse, error)) (*JSONRPCResponse, error) {
+	if !dedupeMethods[method] {
+		return fn(ctx, method, params)
+	}
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return fn(ctx, method, params)
+	}
+	key := method + string(encoded)
+	deadline, _ := ctx.Deadline()
+
+	g.mu.Lock()
+	call, ok := g.calls[key]
+	if ok && !deadline.IsZero() && (call.deadline.IsZero() || deadline.Before(call.deadline)) {
+		g.mu.Unlock()
+		return fn(ctx, method, params)
+	}
+	if !ok {
+		var callCtx context.Context
+		var cancel context.CancelFunc
+		if deadline.IsZero() {
+			callCtx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+		} else {
+			callCtx, cancel = context.WithDeadline(context.WithoutCancel(ctx), deadline)
+		}
+		call = &inflightCall{done: make(chan struct{}), cancel: cancel, deadline: deadline}
+		g.calls[key] = call
+		go g.run(callCtx, key, call, method, params, fn)
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.resp, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// Nobody is waiting any more: abandon the request so that new
+			// callers start a fresh one.
+			g.forget(key, call)
+			call.cancel()
+		}
+		g.mu.Unlock()
+		return nil, ContextError(ctx, ctx.Err())
+	}
+}
+
+// run performs the shared call and publishes its result to the waiters.
+func (g *callGroup) run(ctx context.Context, key string, call *inflightCall, method string, params []interface{}, fn func(context.Context, string, []interface{}) (*JSONRPCResponse, error)) {
+	defer func() {
+		g.mu.Lock()
+		g.forget(key, call)
+		g.mu.Unlock()
+		call.cancel()
+		close(call.done)
+	}()
+
+	call.resp, call.err = fn(ctx, method, params)
+}
+
+// forget removes call from the group if it is still registered under key.
+// The caller must hold g.mu.
+func (g *callGroup) forget(key string, call *inflightCall) {
+	if g.calls[key] == call {
+		delete(g.calls, key)
+	}
+}
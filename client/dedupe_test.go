@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+func TestCallGroupFirstCallerCancel(t *testing.T) {
+	g := &callGroup{calls: make(map[string]*inflightCall)}
+	release := make(chan struct{})
+	var calls atomic.Int32
+	fn := func(ctx context.Context, method string, params []interface{}) (*JSONRPCResponse, error) {
+		calls.Add(1)
+		select {
+		case <-release:
+			return &JSONRPCResponse{ID: 1}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := g.do(firstCtx, "eth_blockNumber", nil, fn)
+		firstErr <- err
+	}()
+	waitForWaiters(t, g, 1)
+
+	second := make(chan error, 1)
+	go func() {
+		resp, err := g.do(context.Background(), "eth_blockNumber", nil, fn)
+		if err == nil && resp == nil {
+			err = errors.New("nil response")
+		}
+		second <- err
+	}()
+	waitForWaiters(t, g, 2)
+
+	cancelFirst()
+	if err := <-firstErr; !errors.Is(err, sdkerrors.ErrContextCanceled) {
+		t.Errorf("first caller error = %v, want ErrContextCanceled", err)
+	}
+
+	close(release)
+	if err := <-second; err != nil {
+		t.Errorf("second caller error = %v, want nil", err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("fn called %d times, want 1", n)
+	}
+}
+
+func TestCallGroupAllCallersCancel(t *testing.T) {
+	g := &callGroup{calls: make(map[string]*inflightCall)}
+	cancelled := make(chan struct{})
+	fn := func(ctx context.Context, method string, params []interface{}) (*JSONRPCResponse, error) {
+		<-ctx.Done()
+		close(cancelled)
+		return nil, ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = g.do(ctx, "eth_blockNumber", nil, fn)
+	}()
+	waitForWaiters(t, g, 1)
+	cancel()
+	<-done
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("shared call was not cancelled after every caller left")
+	}
+
+	g.mu.Lock()
+	n := len(g.calls)
+	g.mu.Unlock()
+	if n != 0 {
+		t.Errorf("%d calls still registered, want 0", n)
+	}
+}
+
+func TestCallGroupStateChangingMethods(t *testing.T) {
+	g := &callGroup{calls: make(map[string]*inflightCall)}
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fn := func(ctx context.Context, method string, params []interface{}) (*JSONRPCResponse, error) {
+		calls.Add(1)
+		<-release
+		return &JSONRPCResponse{ID: 1}, nil
+	}
+
+	for _, method := range []string{"eth_newFilter", "eth_newBlockFilter", "eth_sendRawTransaction"} {
+		calls.Store(0)
+		release = make(chan struct{})
+		done := make(chan struct{}, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				_, _ = g.do(context.Background(), method, []interface{}{"0x1"}, fn)
+				done <- struct{}{}
+			}()
+		}
+		for calls.Load() < 2 {
+			time.Sleep(time.Millisecond)
+		}
+		close(release)
+		<-done
+		<-done
+		if n := calls.Load(); n != 2 {
+			t.Errorf("%s: fn called %d times, want 2", method, n)
+		}
+	}
+}
+
+func TestCallGroupDeadline(t *testing.T) {
+	g := &callGroup{calls: make(map[string]*inflightCall)}
+	release := make(chan struct{})
+	deadlines := make(chan time.Time, 2)
+	fn := func(ctx context.Context, method string, params []interface{}) (*JSONRPCResponse, error) {
+		d, _ := ctx.Deadline()
+		deadlines <- d
+		<-release
+		return &JSONRPCResponse{ID: 1}, nil
+	}
+
+	first := time.Now().Add(time.Hour)
+	firstCtx, cancelFirst := context.WithDeadline(context.Background(), first)
+	defer cancelFirst()
+	done := make(chan struct{}, 3)
+	go func() {
+		_, _ = g.do(firstCtx, "eth_blockNumber", nil, fn)
+		done <- struct{}{}
+	}()
+	if d := <-deadlines; !d.Equal(first) {
+		t.Errorf("shared call deadline = %v, want the caller's %v", d, first)
+	}
+
+	// A caller with a later deadline joins; one with an earlier deadline
+	// sends its own request.
+	laterCtx, cancelLater := context.WithDeadline(context.Background(), first.Add(time.Hour))
+	defer cancelLater()
+	go func() {
+		_, _ = g.do(laterCtx, "eth_blockNumber", nil, fn)
+		done <- struct{}{}
+	}()
+	waitForWaiters(t, g, 2)
+
+	earlier := first.Add(-time.Minute)
+	earlierCtx, cancelEarlier := context.WithDeadline(context.Background(), earlier)
+	defer cancelEarlier()
+	go func() {
+		_, _ = g.do(earlierCtx, "eth_blockNumber", nil, fn)
+		done <- struct{}{}
+	}()
+	if d := <-deadlines; !d.Equal(earlier) {
+		t.Errorf("earlier caller deadline = %v, want its own %v", d, earlier)
+	}
+
+	close(release)
+	for i := 0; i < 3; i++ {
+		<-done
+	}
+}
+
+// waitForWaiters waits until the only in-flight call has n waiters.
+func waitForWaiters(t *testing.T, g *callGroup, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		g.mu.Lock()
+		for _, call := range g.calls {
+			if call.waiters == n {
+				g.mu.Unlock()
+				return
+			}
+		}
+		g.mu.Unlock()
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d waiters", n)
+}
@@ -15,13 +15,22 @@ import (
 )
 
 // HTTPClient is the HTTP client for making API requests.
+// It is safe for concurrent use by multiple goroutines; SetRetryPredicate
+// must be called before the client is shared.
 type HTTPClient struct {
 	baseURL     string
 	apiKey      string
 	httpClient  *http.Client
+	timeout     time.Duration
 	middlewares []Middleware
+	handler     Handler
 	retrier     *Retrier
 	debug       bool
+	strict      bool
+	closed      atomic.Bool
+	// ownsConns is true if the SDK created the HTTP client and its
+	// transport, so Close may drop their idle connections.
+	ownsConns bool
 }
 
 // HTTPClientConfig holds configuration for HTTPClient.
@@ -33,18 +42,39 @@ type HTTPClientConfig struct {
 	RetryDelay    time.Duration
 	RetryMaxDelay time.Duration
 	HTTPClient    *http.Client
+	Transport     http.RoundTripper
 	Middlewares   []Middleware
 	Debug         bool
+	// StrictDecoding rejects response fields that the result type does not
+	// model. See HTTPClient.Unmarshal.
+	StrictDecoding bool
 }
 
 // NewHTTPClient creates a new HTTPClient.
+// cfg.Timeout bounds each request attempt unless the request context has an
+// earlier deadline; a custom cfg.HTTPClient's own Timeout still applies.
+// cfg.Transport, if set, replaces the transport of the HTTP client, e.g. with
+// a RecordingTransport or ReplayTransport.
 func NewHTTPClient(cfg HTTPClientConfig) *HTTPClient {
 	httpClient := cfg.HTTPClient
+	ownsConns := httpClient == nil && cfg.Transport == nil
 	if httpClient == nil {
-		httpClient = &http.Client{
-			Timeout: cfg.Timeout,
+		httpClient = &http.Client{}
+	}
+	if ownsConns {
+		// Use a private connection pool so that Close does not affect
+		// http.DefaultTransport.
+		if t, ok := http.DefaultTransport.(*http.Transport); ok {
+			httpClient.Transport = t.Clone()
+		} else {
+			ownsConns = false
 		}
 	}
+	if cfg.Transport != nil {
+		c := *httpClient
+		c.Transport = cfg.Transport
+		httpClient = &c
+	}
 
 	retrier := &Retrier{
 		MaxRetries:   cfg.MaxRetries,
@@ -53,14 +83,39 @@ func NewHTTPClient(cfg HTTPClientConfig) *HTTPClient {
 		Multiplier:   2.0,
 	}
 
-	return &HTTPClient{
+	c := &HTTPClient{
 		baseURL:     cfg.BaseURL,
 		apiKey:      cfg.APIKey,
 		httpClient:  httpClient,
+		timeout:     cfg.Timeout,
 		middlewares: cfg.Middlewares,
 		retrier:     retrier,
 		debug:       cfg.Debug,
+		strict:      cfg.StrictDecoding,
+		ownsConns:   ownsConns,
+	}
+	// The handler chain is built once so that concurrent requests share
+	// the same immutable middleware stack.
+	c.handler = c.doRequest
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		c.handler = c.middlewares[i].Wrap(c.handler)
 	}
+	return c
+}
+
+// Close closes the idle connections of the HTTP client the SDK created; a
+// client or transport supplied in HTTPClientConfig may be shared and is left
+// untouched. Requests made after Close fail with errors.ErrClientClosed;
+// requests already in flight are not interrupted. Close is safe to call more
+// than once.
+func (c *HTTPClient) Close() error {
+	if c.closed.Swap(true) {
+		return nil
+	}
+	if c.ownsConns {
+		c.httpClient.CloseIdleConnections()
+	}
+	return nil
 }
 
 // BaseURL returns the base URL.
@@ -68,24 +123,56 @@ func (c *HTTPClient) BaseURL() string {
 	return c.baseURL
 }
 
+// APIKey returns the API key.
+func (c *HTTPClient) APIKey() string {
+	return c.apiKey
+}
+
+// Unmarshal decodes a JSON response body into v. With strict decoding
+// enabled, fields of the response that v does not model are an error, which
+// surfaces API schema drift. Types with a custom UnmarshalJSON decode their
+// own fields leniently.
+func (c *HTTPClient) Unmarshal(data []byte, v interface{}) error {
+	if !c.strict {
+		return json.Unmarshal(data, v)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// SetRetryPredicate sets the function that decides whether a failed request
+// is retried, replacing errors.IsRetryable. Passing nil restores the default.
+// It applies to HTTP failures and to JSON-RPC error responses, and must be
+// called before the client is used concurrently.
+func (c *HTTPClient) SetRetryPredicate(fn func(error) bool) {
+	c.retrier.Predicate = fn
+}
+
 // Do executes an HTTP request with retry and middleware support.
+// Each attempt sends a copy of req; if req has a body it must set GetBody
+// (as http.NewRequest does for in-memory readers) to be retried.
 func (c *HTTPClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
-	// Build the handler chain with middlewares
-	handler := c.doRequest
-	for i := len(c.middlewares) - 1; i >= 0; i-- {
-		handler = c.middlewares[i].Wrap(handler)
+	if c.closed.Load() {
+		return nil, errors.ErrClientClosed
 	}
 
 	var resp *http.Response
 	var lastErr error
+	attempt := 0
 
 	err := c.retrier.Do(ctx, func() error {
-		var err error
-		resp, err = handler(ctx, req)
+		attemptReq, err := requestForAttempt(ctx, req, attempt)
+		attempt++
+		if err != nil {
+			return &stopRetry{err: err}
+		}
+
+		resp, err = c.handler(ctx, attemptReq)
 		if err != nil {
 			lastErr = err
 			// Check if error is retryable
-			if errors.IsRetryable(err) {
+			if c.retrier.ShouldRetry(err) {
 				return err
 			}
 			// Non-retryable error, stop retrying
@@ -117,19 +204,45 @@ func (c *HTTPClient) Do(ctx context.Context, req *http.Request) (*http.Response,
 	return resp, nil
 }
 
-// doRequest executes a single HTTP request.
+// requestForAttempt returns a copy of req for the given attempt, with its
+// own headers and, after the first attempt, a fresh body.
+func requestForAttempt(ctx context.Context, req *http.Request, attempt int) (*http.Request, error) {
+	r := req.Clone(ctx)
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		r.Body = req.Body
+		return r, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("REQUEST_ERROR", "request body cannot be replayed for retry")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, errors.Wrap(err, "REQUEST_ERROR", "failed to rewind request body")
+	}
+	r.Body = body
+	return r, nil
+}
+
+// doRequest executes a single HTTP request attempt.
+// See attemptContext for how the configured timeout and ctx interact.
 func (c *HTTPClient) doRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
-	req = req.WithContext(ctx)
-	return c.httpClient.Do(req)
+	attemptCtx, cancel := c.attemptContext(ctx)
+	resp, err := c.httpClient.Do(req.WithContext(attemptCtx))
+	if err != nil {
+		cancel()
+		return nil, ContextError(ctx, err)
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
 }
 
 // Post makes a POST request with JSON body.
 func (c *HTTPClient) Post(ctx context.Context, path string, body interface{}) ([]byte, error) {
-	url := c.baseURL + "/" + c.apiKey
-	if path != "" {
-		url = url + "/" + path
-	}
+	return c.PostURL(ctx, c.endpoint(path), body)
+}
 
+// PostURL makes a POST request with JSON body to an absolute URL.
+func (c *HTTPClient) PostURL(ctx context.Context, fullURL string, body interface{}) ([]byte, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		data, err := json.Marshal(body)
@@ -139,7 +252,7 @@ func (c *HTTPClient) Post(ctx context.Context, path string, body interface{}) ([
 		bodyReader = bytes.NewReader(data)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fullURL, bodyReader)
 	if err != nil {
 		return nil, errors.Wrap(err, "REQUEST_ERROR", "failed to create request")
 	}
@@ -155,6 +268,9 @@ func (c *HTTPClient) Post(ctx context.Context, path string, body interface{}) ([
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
+		if ctxErr := ContextError(ctx, err); ctxErr != err {
+			return nil, ctxErr
+		}
 		return nil, errors.Wrap(err, "READ_ERROR", "failed to read response body")
 	}
 
@@ -168,12 +284,12 @@ func (c *HTTPClient) Post(ctx context.Context, path string, body interface{}) ([
 
 // Get makes a GET request.
 func (c *HTTPClient) Get(ctx context.Context, path string) ([]byte, error) {
-	url := c.baseURL + "/" + c.apiKey
-	if path != "" {
-		url = url + "/" + path
-	}
+	return c.GetURL(ctx, c.endpoint(path))
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+// GetURL makes a GET request to an absolute URL.
+func (c *HTTPClient) GetURL(ctx context.Context, fullURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "REQUEST_ERROR", "failed to create request")
 	}
@@ -188,6 +304,9 @@ func (c *HTTPClient) Get(ctx context.Context, path string) ([]byte, error) {
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
+		if ctxErr := ContextError(ctx, err); ctxErr != err {
+			return nil, ctxErr
+		}
 		return nil, errors.Wrap(err, "READ_ERROR", "failed to read response body")
 	}
 
@@ -200,12 +319,7 @@ func (c *HTTPClient) Get(ctx context.Context, path string) ([]byte, error) {
 
 // GetWithQuery makes a GET request with query parameters.
 func (c *HTTPClient) GetWithQuery(ctx context.Context, path string, query map[string]string) ([]byte, error) {
-	url := c.baseURL + "/" + c.apiKey
-	if path != "" {
-		url = url + "/" + path
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint(path), nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "REQUEST_ERROR", "failed to create request")
 	}
@@ -228,6 +342,9 @@ func (c *HTTPClient) GetWithQuery(ctx context.Context, path string, query map[st
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
+		if ctxErr := ContextError(ctx, err); ctxErr != err {
+			return nil, ctxErr
+		}
 		return nil, errors.Wrap(err, "READ_ERROR", "failed to read response body")
 	}
 
@@ -238,6 +355,15 @@ func (c *HTTPClient) GetWithQuery(ctx context.Context, path string, query map[st
 	return respBody, nil
 }
 
+// endpoint builds the request URL for the given path under the base URL and API key.
+func (c *HTTPClient) endpoint(path string) string {
+	url := c.baseURL + "/" + c.apiKey
+	if path != "" {
+		url = url + "/" + path
+	}
+	return url
+}
+
 // stopRetry is used to signal that retrying should stop.
 type stopRetry struct {
 	err error
@@ -247,10 +373,10 @@ func (s *stopRetry) Error() string {
 	return s.err.Error()
 }
 
-// RequestID is used to generate unique request IDs.
+// requestIDCounter is the process-wide JSON-RPC request ID counter.
 var requestIDCounter uint64
 
-// NextRequestID returns the next request ID.
+// NextRequestID returns the next request ID. It is safe for concurrent use.
 func NextRequestID() uint64 {
 	return atomic.AddUint64(&requestIDCounter, 1)
 }
@@ -272,8 +398,11 @@ type JSONRPCResponse struct {
 }
 
 // JSONRPCClient is a client for making JSON-RPC calls.
+// It is safe for concurrent use by multiple goroutines; SetDeduplicate and
+// SetRetryPredicate must be called before the client is shared.
 type JSONRPCClient struct {
 	httpClient *HTTPClient
+	dedupe     *callGroup
 }
 
 // NewJSONRPCClient creates a new JSONRPCClient.
@@ -283,62 +412,119 @@ func NewJSONRPCClient(httpClient *HTTPClient) *JSONRPCClient {
 	}
 }
 
+// HTTPClient returns the underlying HTTP client, e.g. for REST endpoints
+// served alongside JSON-RPC.
+func (c *JSONRPCClient) HTTPClient() *HTTPClient {
+	return c.httpClient
+}
+
+// SetRetryPredicate sets the function that decides whether a failed call is
+// retried. See HTTPClient.SetRetryPredicate.
+func (c *JSONRPCClient) SetRetryPredicate(fn func(error) bool) {
+	c.httpClient.SetRetryPredicate(fn)
+}
+
 // Call makes a JSON-RPC call and unmarshals the result.
+// JSON-RPC error responses accepted by the retry predicate are retried with
+// the HTTP client's backoff settings.
 func (c *JSONRPCClient) Call(ctx context.Context, method string, params []interface{}, result interface{}) error {
-	req := &JSONRPCRequest{
-		JSONRPC: "2.0",
-		Method:  method,
-		Params:  params,
-		ID:      NextRequestID(),
-	}
-
-	respBody, err := c.httpClient.Post(ctx, "", req)
+	resp, err := c.call(ctx, method, params)
 	if err != nil {
 		return err
 	}
 
-	var resp JSONRPCResponse
-	if err := json.Unmarshal(respBody, &resp); err != nil {
-		return errors.Wrap(err, "UNMARSHAL_ERROR", "failed to unmarshal JSON-RPC response")
+	if result != nil && len(resp.Result) > 0 {
+		if err := c.httpClient.Unmarshal(resp.Result, result); err != nil {
+			return errors.Wrap(err, "UNMARSHAL_ERROR", "failed to unmarshal result")
+		}
 	}
 
-	if resp.Error != nil {
-		return resp.Error
+	return nil
+}
+
+// CallOptional makes a JSON-RPC call for a single object that may not exist.
+// It returns false, leaving result untouched, if the result was JSON null.
+func (c *JSONRPCClient) CallOptional(ctx context.Context, method string, params []interface{}, result interface{}) (bool, error) {
+	resp, err := c.call(ctx, method, params)
+	if err != nil {
+		return false, err
 	}
 
-	if result != nil && len(resp.Result) > 0 {
-		if err := json.Unmarshal(resp.Result, result); err != nil {
-			return errors.Wrap(err, "UNMARSHAL_ERROR", "failed to unmarshal result")
+	if IsNullResult(resp.Result) {
+		return false, nil
+	}
+	if result != nil {
+		if err := c.httpClient.Unmarshal(resp.Result, result); err != nil {
+			return false, errors.Wrap(err, "UNMARSHAL_ERROR", "failed to unmarshal result")
 		}
 	}
 
-	return nil
+	return true, nil
+}
+
+// IsNullResult returns true if a raw JSON-RPC result is absent or JSON null.
+func IsNullResult(result json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(result)
+	return len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null"))
 }
 
 // CallRaw makes a JSON-RPC call and returns the raw result.
 func (c *JSONRPCClient) CallRaw(ctx context.Context, method string, params []interface{}) (json.RawMessage, error) {
-	req := &JSONRPCRequest{
-		JSONRPC: "2.0",
-		Method:  method,
-		Params:  params,
-		ID:      NextRequestID(),
-	}
-
-	respBody, err := c.httpClient.Post(ctx, "", req)
+	resp, err := c.call(ctx, method, params)
 	if err != nil {
 		return nil, err
 	}
 
-	var resp JSONRPCResponse
-	if err := json.Unmarshal(respBody, &resp); err != nil {
-		return nil, errors.Wrap(err, "UNMARSHAL_ERROR", "failed to unmarshal JSON-RPC response")
+	return resp.Result, nil
+}
+
+// call sends a JSON-RPC request, sharing it with identical in-flight calls
+// when deduplication is enabled.
+func (c *JSONRPCClient) call(ctx context.Context, method string, params []interface{}) (*JSONRPCResponse, error) {
+	if c.dedupe == nil {
+		return c.send(ctx, method, params)
 	}
+	return c.dedupe.do(ctx, method, params, c.send)
+}
 
-	if resp.Error != nil {
-		return nil, resp.Error
+// send sends a JSON-RPC request, retrying JSON-RPC error responses that the
+// retry predicate accepts. HTTP-level failures are already retried by Post
+// and are returned as-is.
+func (c *JSONRPCClient) send(ctx context.Context, method string, params []interface{}) (*JSONRPCResponse, error) {
+	var resp JSONRPCResponse
+
+	err := c.httpClient.retrier.Do(ctx, func() error {
+		req := &JSONRPCRequest{
+			JSONRPC: "2.0",
+			Method:  method,
+			Params:  params,
+			ID:      NextRequestID(),
+		}
+
+		respBody, err := c.httpClient.Post(ctx, "", req)
+		if err != nil {
+			return &stopRetry{err: err}
+		}
+
+		resp = JSONRPCResponse{}
+		if err := json.Unmarshal(respBody, &resp); err != nil {
+			return &stopRetry{err: errors.Wrap(err, "UNMARSHAL_ERROR", "failed to unmarshal JSON-RPC response")}
+		}
+
+		if resp.Error != nil {
+			return resp.Error
+		}
+		return nil
+	})
+
+	if err != nil {
+		if sr, ok := err.(*stopRetry); ok {
+			return nil, sr.err
+		}
+		return nil, err
 	}
 
-	return resp.Result, nil
+	return &resp, nil
 }
 
 // BatchCall represents a single call in a batch request.
@@ -415,7 +601,7 @@ func (c *JSONRPCClient) BatchCall(ctx context.Context, calls []BatchCall) ([]Bat
 		}
 
 		if call.Result != nil && len(resp.Result) > 0 {
-			if err := json.Unmarshal(resp.Result, call.Result); err != nil {
+			if err := c.httpClient.Unmarshal(resp.Result, call.Result); err != nil {
 				results[i] = BatchResult{
 					Error: errors.Wrap(err, "UNMARSHAL_ERROR", "failed to unmarshal result"),
 				}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestIsNullResult(t *testing.T) {
+	tests := []struct {
+		result string
+		want   bool
+	}{
+		{"", true},
+		{"null", true},
+		{" null\n", true},
+		{"{}", false},
+		{`"null"`, false},
+		{"0x0", false},
+		{"[]", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsNullResult(json.RawMessage(tt.result)); got != tt.want {
+			t.Errorf("IsNullResult(%q) = %v, want %v", tt.result, got, tt.want)
+		}
+	}
+}
+
+// idleCountingTransport counts CloseIdleConnections calls.
+type idleCountingTransport struct {
+	http.RoundTripper
+	closed int
+}
+
+func (t *idleCountingTransport) CloseIdleConnections() {
+	t.closed++
+}
+
+func TestHTTPClientCloseSharedClient(t *testing.T) {
+	shared := &idleCountingTransport{RoundTripper: http.DefaultTransport}
+	c := NewHTTPClient(HTTPClientConfig{HTTPClient: &http.Client{Transport: shared}})
+	_ = c.Close()
+	if shared.closed != 0 {
+		t.Errorf("Close() closed idle connections of a caller-supplied client")
+	}
+
+	transport := &idleCountingTransport{RoundTripper: http.DefaultTransport}
+	c = NewHTTPClient(HTTPClientConfig{Transport: transport})
+	_ = c.Close()
+	if transport.closed != 0 {
+		t.Errorf("Close() closed idle connections of a caller-supplied transport")
+	}
+
+	c = NewHTTPClient(HTTPClientConfig{})
+	if c.httpClient.Transport == nil || c.httpClient.Transport == http.DefaultTransport {
+		t.Errorf("SDK-created client shares http.DefaultTransport")
+	}
+	if !c.ownsConns {
+		t.Errorf("SDK-created client does not own its connections")
+	}
+}
@@ -0,0 +1,295 @@
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+// Fixture is a recorded HTTP exchange as stored on disk.
+type Fixture struct {
+	// Name identifies the call: the JSON-RPC method, "batch", or the
+	// HTTP method and final URL path segment for REST endpoints.
+	Name string `json:"name"`
+	// Request is the canonical request payload the fixture is keyed by.
+	Request json.RawMessage `json:"request,omitempty"`
+	// StatusCode is the recorded HTTP status code.
+	StatusCode int `json:"statusCode"`
+	// Header holds the recorded response headers.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the recorded response body.
+	Body json.RawMessage `json:"body"`
+}
+
+// RecordingTransport is an http.RoundTripper that forwards requests to Next
+// and writes each response to Dir as a JSON fixture.
+// Fixtures are keyed by JSON-RPC method and params (or, for REST endpoints,
+// by HTTP method, final path segment, query and body); request IDs, hosts and
+// API keys in URLs are not part of the key, so recordings replay against any
+// endpoint. Responses are recorded as returned, including errors.
+type RecordingTransport struct {
+	// Dir is the fixture directory. It is created if it does not exist.
+	Dir string
+	// Next performs the real request. If nil, http.DefaultTransport is used.
+	Next http.RoundTripper
+
+	mu sync.Mutex
+}
+
+// NewRecordingTransport creates a RecordingTransport writing to dir.
+func NewRecordingTransport(dir string, next http.RoundTripper) *RecordingTransport {
+	return &RecordingTransport{Dir: dir, Next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key, err := fixtureKeyFor(req)
+	if err != nil {
+		return nil, err
+	}
+
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	fixture := Fixture{
+		Name:       key.name,
+		Request:    key.payload,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       rawBody(body),
+	}
+	if err := t.write(key.file(), &fixture); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// write stores a fixture on disk.
+func (t *RecordingTransport) write(name string, fixture *Fixture) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := os.MkdirAll(t.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+	b, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(t.Dir, name), append(b, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	return nil
+}
+
+// ReplayTransport is an http.RoundTripper that serves responses recorded by
+// RecordingTransport without making network requests.
+// JSON-RPC response IDs are rewritten to match the replayed request.
+// A request without a fixture fails with errors.ErrFixtureNotFound.
+type ReplayTransport struct {
+	// Dir is the fixture directory.
+	Dir string
+}
+
+// NewReplayTransport creates a ReplayTransport reading from dir.
+func NewReplayTransport(dir string) *ReplayTransport {
+	return &ReplayTransport{Dir: dir}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key, err := fixtureKeyFor(req)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(filepath.Join(t.Dir, key.file()))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: no fixture for %s in %s", errors.ErrFixtureNotFound, key.name, t.Dir)
+		}
+		return nil, err
+	}
+	var fixture Fixture
+	if err := json.Unmarshal(b, &fixture); err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", key.file(), err)
+	}
+
+	body := []byte(fixture.Body)
+	var s string
+	if json.Unmarshal(body, &s) == nil {
+		// Non-JSON bodies are stored as strings.
+		body = []byte(s)
+	}
+	body = rewriteRPCIDs(body, key.ids)
+
+	header := fixture.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Del("Content-Length")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fixture.StatusCode, http.StatusText(fixture.StatusCode)),
+		StatusCode:    fixture.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// fixtureKey identifies a request independently of its ID and endpoint.
+type fixtureKey struct {
+	name    string
+	payload json.RawMessage
+	// ids are the JSON-RPC request IDs, in request order.
+	ids []json.RawMessage
+}
+
+// file returns the fixture file name.
+func (k fixtureKey) file() string {
+	sum := sha256.Sum256(append([]byte(k.name+"\n"), k.payload...))
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, k.name)
+	return name + "-" + hex.EncodeToString(sum[:8]) + ".json"
+}
+
+// rpcCall is the keyed part of a JSON-RPC request.
+type rpcCall struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// fixtureKeyFor derives the fixture key of req, restoring its body.
+func fixtureKeyFor(req *http.Request) (fixtureKey, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return fixtureKey{}, err
+		}
+		body = b
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if req.Method == http.MethodPost && len(body) > 0 {
+		var call rpcCall
+		if json.Unmarshal(body, &call) == nil && call.Method != "" {
+			payload, _ := json.Marshal(rpcCall{Method: call.Method, Params: compactJSON(call.Params)})
+			return fixtureKey{name: call.Method, payload: payload, ids: []json.RawMessage{call.ID}}, nil
+		}
+
+		var calls []rpcCall
+		if json.Unmarshal(body, &calls) == nil && len(calls) > 0 && calls[0].Method != "" {
+			ids := make([]json.RawMessage, len(calls))
+			keyed := make([]rpcCall, len(calls))
+			for i, c := range calls {
+				ids[i] = c.ID
+				keyed[i] = rpcCall{Method: c.Method, Params: compactJSON(c.Params)}
+			}
+			payload, _ := json.Marshal(keyed)
+			return fixtureKey{name: "batch", payload: payload, ids: ids}, nil
+		}
+	}
+
+	// REST endpoints carry the API key in the path, so only the final
+	// segment (the API method) is part of the key.
+	payload, _ := json.Marshal(map[string]interface{}{
+		"query": req.URL.Query().Encode(),
+		"body":  rawBody(body),
+	})
+	return fixtureKey{name: req.Method + " " + path.Base(req.URL.Path), payload: payload}, nil
+}
+
+// rewriteRPCIDs replaces the IDs of a recorded JSON-RPC response with ids.
+// Batch responses are matched to the request by position.
+func rewriteRPCIDs(body []byte, ids []json.RawMessage) []byte {
+	if len(ids) == 0 {
+		return body
+	}
+
+	var single map[string]json.RawMessage
+	if json.Unmarshal(body, &single) == nil {
+		if _, ok := single["id"]; ok && ids[0] != nil {
+			single["id"] = ids[0]
+			if b, err := json.Marshal(single); err == nil {
+				return b
+			}
+		}
+		return body
+	}
+
+	var batch []map[string]json.RawMessage
+	if json.Unmarshal(body, &batch) != nil || len(batch) != len(ids) {
+		return body
+	}
+	for i := range batch {
+		if ids[i] != nil {
+			batch[i]["id"] = ids[i]
+		}
+	}
+	if b, err := json.Marshal(batch); err == nil {
+		return b
+	}
+	return body
+}
+
+// compactJSON removes insignificant whitespace from a JSON value.
+func compactJSON(b json.RawMessage) json.RawMessage {
+	if len(b) == 0 {
+		return b
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return b
+	}
+	return buf.Bytes()
+}
+
+// rawBody returns b as a JSON value, or as a JSON string if it is not valid JSON.
+func rawBody(b []byte) json.RawMessage {
+	if len(b) == 0 {
+		return json.RawMessage(`""`)
+	}
+	if json.Valid(b) {
+		return compactJSON(b)
+	}
+	s, _ := json.Marshal(string(b))
+	return s
+}
@@ -21,6 +21,9 @@ type Retrier struct {
 	Multiplier float64
 	// Jitter adds randomness to the delay (0.0 to 1.0).
 	Jitter float64
+	// Predicate decides whether an error is retried.
+	// If nil, errors.IsRetryable is used.
+	Predicate func(error) bool
 }
 
 // DefaultRetrier returns a Retrier with default settings.
@@ -112,6 +115,9 @@ func (r *Retrier) calculateDelay(attempt int) time.Duration {
 
 // ShouldRetry determines if an error is retryable.
 func (r *Retrier) ShouldRetry(err error) bool {
+	if r.Predicate != nil {
+		return r.Predicate(err)
+	}
 	return errors.IsRetryable(err)
 }
 
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+// attemptContext returns the context for a single request attempt.
+//
+// Timeout precedence: the configured timeout bounds each attempt, but only
+// when it is tighter than the caller's deadline. If ctx already has a
+// deadline sooner than now+timeout, ctx is used unchanged, so a caller's
+// context.WithTimeout always wins. Retries and backoff are bounded only by
+// ctx.
+func (c *HTTPClient) attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout <= 0 {
+		return ctx, func() {}
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= c.timeout {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// cancelOnClose releases an attempt context when the response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer.
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// ContextError maps a request failure caused by the caller's context to
+// errors.ErrContextCanceled or errors.ErrContextDeadline. Other errors are
+// returned unchanged. Packages built on the client use it so that every
+// cancellation surfaces the same sentinel errors.
+func ContextError(ctx context.Context, err error) error {
+	switch ctx.Err() {
+	case context.Canceled:
+		return errors.ErrContextCanceled
+	case context.DeadlineExceeded:
+		return errors.ErrContextDeadline
+	default:
+		return err
+	}
+}
@@ -3,8 +3,14 @@ package alchemy
 import (
 	"net/http"
 	"time"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/data"
 )
 
+// AddressLabeler resolves human-readable labels for addresses.
+// See data.AddressLabeler.
+type AddressLabeler = data.AddressLabeler
+
 // Config holds the configuration for the Alchemy client.
 type Config struct {
 	// APIKey is the Alchemy API key (required).
@@ -17,7 +23,22 @@ type Config struct {
 	// If empty, the endpoint is derived from Network.
 	BaseURL string
 
-	// Timeout is the request timeout (default: 30s).
+	// PricesURL overrides the Prices API endpoint.
+	// If empty, prices.DefaultBaseURL is used.
+	PricesURL string
+
+	// BeaconURL overrides the Beacon API endpoint used for blob sidecars.
+	// If empty, the endpoint is derived from Network; networks without a
+	// Beacon API have none.
+	BeaconURL string
+
+	// PortfolioURL overrides the Portfolio API endpoint.
+	// If empty, data.DefaultPortfolioURL is used.
+	PortfolioURL string
+
+	// Timeout is the per-attempt request timeout (default: 30s).
+	// It is not applied when the request context has an earlier deadline,
+	// so a caller's context.WithTimeout takes precedence.
 	Timeout time.Duration
 
 	// MaxRetries is the maximum number of retry attempts (default: 3).
@@ -33,6 +54,24 @@ type Config struct {
 	// If nil, a default client is created.
 	HTTPClient *http.Client
 
+	// Transport overrides the transport of the HTTP client. Use
+	// client.NewRecordingTransport and client.NewReplayTransport to record
+	// real responses to disk and replay them in tests.
+	Transport http.RoundTripper
+
+	// AddressLabeler, if set, labels addresses in asset transfer results,
+	// e.g. data.NewMetadataLabeler or a custom exchange-name directory.
+	AddressLabeler AddressLabeler
+
+	// Deduplicate shares a single upstream request among concurrent
+	// JSON-RPC calls with the same read-only method and params.
+	Deduplicate bool
+
+	// StrictDecoding rejects JSON-RPC results and NFT/Portfolio API
+	// responses containing fields the SDK does not model, to detect API
+	// schema changes (e.g. in tests). The default is lenient decoding.
+	StrictDecoding bool
+
 	// Debug enables debug logging.
 	Debug bool
 }
@@ -88,6 +127,15 @@ func (c *Config) GetBaseURL() string {
 	return c.Network.BaseURL()
 }
 
+// GetBeaconURL returns the base URL for Beacon API requests, or an empty
+// string if none is configured and the network has no Beacon API.
+func (c *Config) GetBeaconURL() string {
+	if c.BeaconURL != "" {
+		return c.BeaconURL
+	}
+	return c.Network.BeaconURL()
+}
+
 // GetHTTPClient returns the HTTP client to use.
 func (c *Config) GetHTTPClient() *http.Client {
 	if c.HTTPClient != nil {
@@ -0,0 +1,267 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"runtime"
+	"strings"
+	"sync"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+// activityQueueSize is the number of jobs buffered per worker.
+const activityQueueSize = 64
+
+// ActivityCallback handles a single address activity. address is the
+// registered address that matched (lowercase), or "" for the default callback.
+type ActivityCallback func(ctx context.Context, address string, activity *AddressActivity) error
+
+// ActivityDispatcher routes the activities of ADDRESS_ACTIVITY events to
+// callbacks registered per address. An activity is delivered once for each
+// registered address it involves, as sender or recipient, compared
+// case-insensitively; activities involving no registered address go to the
+// default callback, if any.
+//
+// Callbacks run on a fixed pool of workers shared by all Dispatch calls.
+// All activities of an address are handled by the same worker, in the order
+// they were dispatched, so callbacks for one address never run concurrently,
+// even across concurrent webhook deliveries. It is safe for concurrent use.
+type ActivityDispatcher struct {
+	mu        sync.RWMutex
+	callbacks map[string][]ActivityCallback
+	fallback  ActivityCallback
+
+	// lifecycle guards queues against Close while jobs are being queued.
+	lifecycle sync.RWMutex
+	closed    bool
+	queues    []chan activityJob
+	wg        sync.WaitGroup
+}
+
+// NewActivityDispatcher creates an ActivityDispatcher that runs callbacks on
+// workers goroutines. Non-positive values use runtime.GOMAXPROCS(0).
+// The workers run until Close is called.
+func NewActivityDispatcher(workers int) *ActivityDispatcher {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	d := &ActivityDispatcher{
+		callbacks: make(map[string][]ActivityCallback),
+		queues:    make([]chan activityJob, workers),
+	}
+	for i := range d.queues {
+		d.queues[i] = make(chan activityJob, activityQueueSize)
+		d.wg.Add(1)
+		go d.work(d.queues[i])
+	}
+	return d
+}
+
+// Close stops the workers after the queued callbacks have run, and waits for
+// them to exit. Dispatch calls made after Close fail with
+// errors.ErrClientClosed. Close is safe to call more than once.
+func (d *ActivityDispatcher) Close() {
+	d.lifecycle.Lock()
+	if !d.closed {
+		d.closed = true
+		for _, queue := range d.queues {
+			close(queue)
+		}
+	}
+	d.lifecycle.Unlock()
+	d.wg.Wait()
+}
+
+// On registers a callback for activities involving address. Several
+// callbacks may be registered for one address; they run in registration order.
+func (d *ActivityDispatcher) On(address string, callback ActivityCallback) *ActivityDispatcher {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := activityAddressKey(address)
+	d.callbacks[key] = append(d.callbacks[key], callback)
+	return d
+}
+
+// OnDefault sets the callback for activities that involve no registered address.
+func (d *ActivityDispatcher) OnDefault(callback ActivityCallback) *ActivityDispatcher {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.fallback = callback
+	return d
+}
+
+// Off removes all callbacks registered for address.
+func (d *ActivityDispatcher) Off(address string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.callbacks, activityAddressKey(address))
+}
+
+// activityJob is a callback invocation queued on a worker.
+type activityJob struct {
+	ctx       context.Context
+	worker    int
+	address   string
+	activity  *AddressActivity
+	callbacks []ActivityCallback
+	batch     *activityBatch
+}
+
+// activityBatch collects the results of the jobs of one Dispatch call.
+type activityBatch struct {
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	errs   []error
+	ctxErr error
+}
+
+// fail records a callback error.
+func (b *activityBatch) fail(err error) {
+	b.mu.Lock()
+	b.errs = append(b.errs, err)
+	b.mu.Unlock()
+}
+
+// cancel records that jobs were skipped because ctx was done.
+func (b *activityBatch) cancel(err error) {
+	b.mu.Lock()
+	if b.ctxErr == nil {
+		b.ctxErr = err
+	}
+	b.mu.Unlock()
+}
+
+// err returns the joined errors of the batch.
+func (b *activityBatch) err() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return errors.Join(append(b.errs, b.ctxErr)...)
+}
+
+// Dispatch delivers the activities of event to the registered callbacks and
+// waits for them to finish. Callback errors are joined and returned; a
+// failing callback does not stop the others. When ctx is done, callbacks not
+// yet started are skipped and ctx.Err() is included in the result.
+func (d *ActivityDispatcher) Dispatch(ctx context.Context, event *AddressActivityEvent) error {
+	if event == nil {
+		return nil
+	}
+
+	batch := &activityBatch{}
+	if err := d.enqueue(ctx, d.plan(ctx, event, batch), batch); err != nil {
+		return err
+	}
+	batch.wg.Wait()
+	return batch.err()
+}
+
+// enqueue queues jobs on their workers, in order.
+func (d *ActivityDispatcher) enqueue(ctx context.Context, jobs []activityJob, batch *activityBatch) error {
+	d.lifecycle.RLock()
+	defer d.lifecycle.RUnlock()
+
+	if d.closed {
+		return sdkerrors.ErrClientClosed
+	}
+	for _, job := range jobs {
+		batch.wg.Add(1)
+		select {
+		case d.queues[job.worker] <- job:
+		case <-ctx.Done():
+			batch.wg.Done()
+			batch.cancel(ctx.Err())
+			return nil
+		}
+	}
+	return nil
+}
+
+// work runs the jobs of a queue until it is closed.
+func (d *ActivityDispatcher) work(queue <-chan activityJob) {
+	defer d.wg.Done()
+	for job := range queue {
+		job.run()
+	}
+}
+
+// run invokes the job's callbacks unless its context is done.
+func (j *activityJob) run() {
+	defer j.batch.wg.Done()
+
+	if err := j.ctx.Err(); err != nil {
+		j.batch.cancel(err)
+		return
+	}
+	for _, callback := range j.callbacks {
+		if err := callback(j.ctx, j.address, j.activity); err != nil {
+			j.batch.fail(err)
+		}
+	}
+}
+
+// HandleAddressActivity dispatches a parsed webhook event. Its signature
+// matches WebhookHandlers.OnAddressActivity:
+//
+//	handler := data.NewWebhookHandler(signingKey, data.WebhookHandlers{
+//		OnAddressActivity: dispatcher.HandleAddressActivity,
+//	})
+//
+// A callback error makes the handler respond with 500, so Alchemy redelivers
+// the whole event; callbacks should therefore be idempotent.
+func (d *ActivityDispatcher) HandleAddressActivity(ctx context.Context, _ *WebhookEvent, activity *AddressActivityEvent) error {
+	return d.Dispatch(ctx, activity)
+}
+
+// plan returns the jobs for the event's activities in event order, each
+// assigned to the worker of its address.
+func (d *ActivityDispatcher) plan(ctx context.Context, event *AddressActivityEvent, batch *activityBatch) []activityJob {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var jobs []activityJob
+	for i := range event.Activity {
+		activity := &event.Activity[i]
+		addresses := []string{activityAddressKey(activity.FromAddress)}
+		if to := activityAddressKey(activity.ToAddress); to != addresses[0] {
+			addresses = append(addresses, to)
+		}
+
+		matched := false
+		for _, address := range addresses {
+			callbacks, ok := d.callbacks[address]
+			if !ok {
+				continue
+			}
+			matched = true
+			jobs = append(jobs, activityJob{
+				ctx: ctx, worker: d.worker(address), address: address,
+				activity: activity, callbacks: callbacks, batch: batch,
+			})
+		}
+
+		if !matched && d.fallback != nil {
+			jobs = append(jobs, activityJob{
+				ctx: ctx, worker: d.worker(""),
+				activity: activity, callbacks: []ActivityCallback{d.fallback}, batch: batch,
+			})
+		}
+	}
+	return jobs
+}
+
+// worker returns the worker index that handles address.
+func (d *ActivityDispatcher) worker(address string) int {
+	h := fnv.New32a()
+	h.Write([]byte(address))
+	return int(h.Sum32() % uint32(len(d.queues)))
+}
+
+// activityAddressKey normalizes an address for case-insensitive matching.
+func activityAddressKey(address string) string {
+	return strings.ToLower(strings.TrimSpace(address))
+}
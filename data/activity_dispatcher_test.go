@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+func TestActivityDispatcherSerializesAddressAcrossDispatches(t *testing.T) {
+	d := NewActivityDispatcher(4)
+	defer d.Close()
+
+	const address = "0x00000000000000000000000000000000000000AA"
+	var running, maxRunning, calls atomic.Int32
+	d.On(address, func(ctx context.Context, _ string, _ *AddressActivity) error {
+		n := running.Add(1)
+		for {
+			max := maxRunning.Load()
+			if n <= max || maxRunning.CompareAndSwap(max, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		calls.Add(1)
+		return nil
+	})
+
+	event := &AddressActivityEvent{Activity: []AddressActivity{
+		{FromAddress: address, ToAddress: "0x01"},
+		{FromAddress: "0x02", ToAddress: address},
+	}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := d.Dispatch(context.Background(), event); err != nil {
+				t.Errorf("Dispatch() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := calls.Load(); n != 16 {
+		t.Errorf("callback ran %d times, want 16", n)
+	}
+	if n := maxRunning.Load(); n != 1 {
+		t.Errorf("up to %d callbacks ran concurrently for one address, want 1", n)
+	}
+}
+
+func TestActivityDispatcherRouting(t *testing.T) {
+	d := NewActivityDispatcher(2)
+	defer d.Close()
+
+	var mu sync.Mutex
+	var got []string
+	record := func(ctx context.Context, address string, activity *AddressActivity) error {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, fmt.Sprintf("%s:%s", address, activity.Hash))
+		return nil
+	}
+	d.On("0xAA", record).OnDefault(record)
+	d.On("0xbb", func(context.Context, string, *AddressActivity) error {
+		return errors.New("callback failed")
+	})
+
+	err := d.Dispatch(context.Background(), &AddressActivityEvent{Activity: []AddressActivity{
+		{FromAddress: "0xaa", ToAddress: "0xcc", Hash: "1"},
+		{FromAddress: "0xdd", ToAddress: "0xee", Hash: "2"},
+		{FromAddress: "0xbb", ToAddress: "0xcc", Hash: "3"},
+	}})
+	if err == nil || err.Error() != "callback failed" {
+		t.Errorf("Dispatch() error = %v, want callback failed", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := map[string]bool{"0xaa:1": true, ":2": true}
+	if len(got) != len(want) {
+		t.Fatalf("callbacks = %v, want %v", got, want)
+	}
+	for _, g := range got {
+		if !want[g] {
+			t.Errorf("unexpected callback %q", g)
+		}
+	}
+}
+
+func TestActivityDispatcherClose(t *testing.T) {
+	d := NewActivityDispatcher(1)
+	d.Close()
+	d.Close()
+
+	event := &AddressActivityEvent{Activity: []AddressActivity{{FromAddress: "0xaa"}}}
+	if err := d.Dispatch(context.Background(), event); !errors.Is(err, sdkerrors.ErrClientClosed) {
+		t.Errorf("Dispatch() after Close error = %v, want ErrClientClosed", err)
+	}
+}
+
+func TestActivityDispatcherCanceledContext(t *testing.T) {
+	d := NewActivityDispatcher(1)
+	defer d.Close()
+
+	var calls atomic.Int32
+	d.On("0xaa", func(context.Context, string, *AddressActivity) error {
+		calls.Add(1)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	event := &AddressActivityEvent{Activity: []AddressActivity{{FromAddress: "0xaa"}, {FromAddress: "0xaa"}}}
+	if err := d.Dispatch(ctx, event); !errors.Is(err, context.Canceled) {
+		t.Errorf("Dispatch() error = %v, want context.Canceled", err)
+	}
+	if n := calls.Load(); n != 0 {
+		t.Errorf("callback ran %d times, want 0", n)
+	}
+}
@@ -0,0 +1,284 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/abi"
+	"github.com/ABT-Tech-Limited/alchemy-go/node"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// maxAllowanceBatchSize is the number of contracts queried per JSON-RPC batch.
+// Each contract costs three calls: allowance, decimals and totalSupply.
+const maxAllowanceBatchSize = 50
+
+// maxSpenderAllowanceBatchSize is the number of spenders queried per
+// JSON-RPC batch by GetTokenAllowancesForSpenders.
+const maxSpenderAllowanceBatchSize = maxAllowanceBatchSize * 3
+
+// Function selectors for on-chain ERC20 lookups.
+var (
+	selectorDecimals    = abi.Selector("0x313ce567") // decimals()
+	selectorTotalSupply = abi.Selector("0x18160ddd") // totalSupply()
+)
+
+// MaxUint256 is 2^256-1, the conventional "unlimited" approval amount.
+var MaxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// unlimitedAllowanceThreshold is the value above which an allowance is
+// considered unlimited regardless of total supply. Unlimited approvals are
+// decremented by each transferFrom, so they are matched by magnitude (at
+// least 2^255) rather than exact equality with MaxUint256.
+var unlimitedAllowanceThreshold = new(big.Int).Lsh(big.NewInt(1), 255)
+
+// TokenAllowance is a typed ERC20 allowance.
+type TokenAllowance struct {
+	// Contract is the token contract address.
+	Contract types.Address
+	// Owner is the owner address.
+	Owner types.Address
+	// Spender is the spender address.
+	Spender types.Address
+	// Value is the raw allowance amount.
+	Value *big.Int
+	// Decimals is the token's decimals, if known.
+	Decimals *int
+	// TotalSupply is the token's total supply, if known.
+	TotalSupply *big.Int
+	// Formatted is Value scaled by Decimals, or the raw value if decimals are unknown.
+	Formatted string
+	// Error is set when the allowance for this contract could not be fetched.
+	Error error
+}
+
+// NewTokenAllowance creates a TokenAllowance from a raw value and optional
+// token decimals and total supply.
+func NewTokenAllowance(value *big.Int, decimals *int, totalSupply *big.Int) *TokenAllowance {
+	if value == nil {
+		value = new(big.Int)
+	}
+	a := &TokenAllowance{Value: value, Decimals: decimals, TotalSupply: totalSupply}
+	a.Formatted = value.String()
+	if decimals != nil && *decimals >= 0 {
+		a.Formatted = formatUnits(value, *decimals)
+	}
+	return a
+}
+
+// IsUnlimited reports whether the allowance is effectively unlimited: at or
+// near 2^256-1, or greater than the token's total supply when it is known.
+func (a *TokenAllowance) IsUnlimited() bool {
+	if a.Value == nil {
+		return false
+	}
+	if a.Value.Cmp(unlimitedAllowanceThreshold) >= 0 {
+		return true
+	}
+	return a.TotalSupply != nil && a.TotalSupply.Sign() > 0 && a.Value.Cmp(a.TotalSupply) > 0
+}
+
+// IsZero reports whether no allowance is granted.
+func (a *TokenAllowance) IsZero() bool {
+	return a.Value == nil || a.Value.Sign() == 0
+}
+
+// GetTokenAllowanceInfo retrieves the allowance for a spender as a typed
+// TokenAllowance, fetching the token's decimals and total supply on-chain.
+func (c *Client) GetTokenAllowanceInfo(ctx context.Context, params *TokenAllowanceParams) (*TokenAllowance, error) {
+	if params == nil {
+		return nil, fmt.Errorf("%w: params are required", sdkerrors.ErrInvalidParameter)
+	}
+
+	allowances, err := c.GetTokenAllowances(ctx, params.Owner, params.Spender, []types.Address{params.Contract})
+	if err != nil {
+		return nil, err
+	}
+	if allowances[0].Error != nil {
+		return nil, allowances[0].Error
+	}
+	return &allowances[0], nil
+}
+
+// GetTokenAllowances retrieves the allowances granted by owner to spender on
+// each of the given token contracts, together with each token's decimals and
+// total supply, using JSON-RPC batches.
+// Results are returned in contract order; per-contract failures are reported
+// in TokenAllowance.Error. Decimals and total supply are left nil for tokens
+// that do not implement them.
+func (c *Client) GetTokenAllowances(ctx context.Context, owner, spender types.Address, contracts []types.Address) ([]TokenAllowance, error) {
+	allowances := make([]TokenAllowance, len(contracts))
+
+	for start := 0; start < len(contracts); start += maxAllowanceBatchSize {
+		end := start + maxAllowanceBatchSize
+		if end > len(contracts) {
+			end = len(contracts)
+		}
+		if err := c.fetchAllowanceBatch(ctx, owner, spender, contracts[start:end], allowances[start:end]); err != nil {
+			return nil, err
+		}
+	}
+
+	return allowances, nil
+}
+
+// fetchAllowanceBatch fetches the allowances for contracts into dst with a
+// single JSON-RPC batch.
+func (c *Client) fetchAllowanceBatch(ctx context.Context, owner, spender types.Address, contracts []types.Address, dst []TokenAllowance) error {
+	const callsPerContract = 3
+
+	responses := make([]TokenAllowanceResponse, len(contracts))
+	decimals := make([]types.Data, len(contracts))
+	supplies := make([]types.Data, len(contracts))
+	calls := make([]client.BatchCall, 0, len(contracts)*callsPerContract)
+
+	for i, contract := range contracts {
+		to := contract
+		calls = append(calls,
+			client.BatchCall{
+				Method: "alchemy_getTokenAllowance",
+				Params: []interface{}{map[string]string{
+					"contract": contract.String(),
+					"owner":    owner.String(),
+					"spender":  spender.String(),
+				}},
+				Result: &responses[i],
+			},
+			client.BatchCall{
+				Method: "eth_call",
+				Params: []interface{}{&node.CallMsg{To: &to, Data: selectorDecimals}, node.BlockLatest.String()},
+				Result: &decimals[i],
+			},
+			client.BatchCall{
+				Method: "eth_call",
+				Params: []interface{}{&node.CallMsg{To: &to, Data: selectorTotalSupply}, node.BlockLatest.String()},
+				Result: &supplies[i],
+			},
+		)
+	}
+
+	results, err := c.rpc.BatchCall(ctx, calls)
+	if err != nil {
+		return err
+	}
+
+	for i, contract := range contracts {
+		base := i * callsPerContract
+		dst[i] = TokenAllowance{Contract: contract, Owner: owner, Spender: spender}
+
+		if err := results[base].Error; err != nil {
+			dst[i].Error = fmt.Errorf("failed to get allowance for %s: %w", contract, err)
+			continue
+		}
+		value, err := responses[i].Value()
+		if err != nil {
+			dst[i].Error = fmt.Errorf("%w: allowance for %s: %v", sdkerrors.ErrInvalidResponse, contract, err)
+			continue
+		}
+
+		var dec *int
+		if results[base+1].Error == nil {
+			if n, err := abi.DecodeUint256(decimals[i].Bytes(), 0); err == nil && n.IsInt64() && n.Int64() <= 255 {
+				d := int(n.Int64())
+				dec = &d
+			}
+		}
+		var supply *big.Int
+		if results[base+2].Error == nil {
+			if n, err := abi.DecodeUint256(supplies[i].Bytes(), 0); err == nil {
+				supply = n
+			}
+		}
+
+		a := NewTokenAllowance(value, dec, supply)
+		a.Contract, a.Owner, a.Spender = contract, owner, spender
+		dst[i] = *a
+	}
+
+	return nil
+}
+
+// GetTokenAllowancesForSpenders retrieves the allowances granted by owner on
+// a single token contract to each of spenders, using JSON-RPC batches. The
+// result is keyed by spender as passed; duplicate spenders are queried once.
+// Unlike GetTokenAllowances, which reports per-contract failures, any failed
+// lookup fails the whole call.
+func (c *Client) GetTokenAllowancesForSpenders(ctx context.Context, contract, owner types.Address, spenders []types.Address) (map[types.Address]*big.Int, error) {
+	unique := make([]types.Address, 0, len(spenders))
+	seen := make(map[types.Address]struct{}, len(spenders))
+	for _, spender := range spenders {
+		if _, ok := seen[spender]; ok {
+			continue
+		}
+		seen[spender] = struct{}{}
+		unique = append(unique, spender)
+	}
+
+	allowances := make(map[types.Address]*big.Int, len(unique))
+	for start := 0; start < len(unique); start += maxSpenderAllowanceBatchSize {
+		end := start + maxSpenderAllowanceBatchSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+		batch := unique[start:end]
+
+		responses := make([]TokenAllowanceResponse, len(batch))
+		calls := make([]client.BatchCall, len(batch))
+		for i, spender := range batch {
+			calls[i] = client.BatchCall{
+				Method: "alchemy_getTokenAllowance",
+				Params: []interface{}{map[string]string{
+					"contract": contract.String(),
+					"owner":    owner.String(),
+					"spender":  spender.String(),
+				}},
+				Result: &responses[i],
+			}
+		}
+
+		results, err := c.rpc.BatchCall(ctx, calls)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, spender := range batch {
+			if err := results[i].Error; err != nil {
+				return nil, fmt.Errorf("failed to get allowance for spender %s: %w", spender, err)
+			}
+			value, err := responses[i].Value()
+			if err != nil {
+				return nil, fmt.Errorf("%w: allowance for spender %s: %v", sdkerrors.ErrInvalidResponse, spender, err)
+			}
+			allowances[spender] = value
+		}
+	}
+
+	return allowances, nil
+}
+
+// formatUnits formats value scaled down by 10^decimals without loss of
+// precision, trimming trailing fractional zeros.
+func formatUnits(value *big.Int, decimals int) string {
+	if decimals == 0 {
+		return value.String()
+	}
+
+	abs := new(big.Int).Abs(value)
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole, frac := new(big.Int).QuoRem(abs, divisor, new(big.Int))
+
+	s := whole.String()
+	if frac.Sign() != 0 {
+		fracStr := frac.String()
+		fracStr = strings.Repeat("0", decimals-len(fracStr)) + fracStr
+		s += "." + strings.TrimRight(fracStr, "0")
+	}
+	if value.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
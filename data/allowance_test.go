@@ -0,0 +1,90 @@
+package data
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNewTokenAllowanceFormatsUSDC(t *testing.T) {
+	decimals := 6
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"0", "0"},
+		{"1", "0.000001"},
+		{"1000000", "1"},
+		{"1500000", "1.5"},
+		{"123456789012", "123456.789012"},
+	}
+
+	for _, tt := range tests {
+		value, _ := new(big.Int).SetString(tt.value, 10)
+		a := NewTokenAllowance(value, &decimals, nil)
+		if a.Formatted != tt.want {
+			t.Errorf("Formatted(%s) = %q, want %q", tt.value, a.Formatted, tt.want)
+		}
+	}
+}
+
+func TestNewTokenAllowanceUnknownDecimals(t *testing.T) {
+	a := NewTokenAllowance(big.NewInt(1500000), nil, nil)
+	if a.Formatted != "1500000" {
+		t.Errorf("Formatted = %q, want raw value", a.Formatted)
+	}
+}
+
+func TestTokenAllowanceIsUnlimited(t *testing.T) {
+	threshold := new(big.Int).Lsh(big.NewInt(1), 255)
+	supply := big.NewInt(1_000_000_000_000)
+
+	tests := []struct {
+		name   string
+		value  *big.Int
+		supply *big.Int
+		want   bool
+	}{
+		{"max uint256", MaxUint256, nil, true},
+		{"max uint256 minus spent", new(big.Int).Sub(MaxUint256, big.NewInt(1_000_000)), nil, true},
+		{"2^255", threshold, nil, true},
+		{"just below 2^255", new(big.Int).Sub(threshold, big.NewInt(1)), nil, false},
+		{"zero", big.NewInt(0), nil, false},
+		{"nil value", nil, nil, false},
+		{"above total supply", new(big.Int).Add(supply, big.NewInt(1)), supply, true},
+		{"equal to total supply", supply, supply, false},
+		{"zero total supply", big.NewInt(1), big.NewInt(0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &TokenAllowance{Value: tt.value, TotalSupply: tt.supply}
+			if got := a.IsUnlimited(); got != tt.want {
+				t.Errorf("IsUnlimited() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		value    int64
+		decimals int
+		want     string
+	}{
+		{0, 18, "0"},
+		{1000000, 6, "1"},
+		{1000000000, 6, "1000"},
+		{1000001, 6, "1.000001"},
+		{-1500000, 6, "-1.5"},
+		{-1000000, 6, "-1"},
+		{-1, 6, "-0.000001"},
+		{42, 0, "42"},
+		{-42, 0, "-42"},
+	}
+
+	for _, tt := range tests {
+		if got := formatUnits(big.NewInt(tt.value), tt.decimals); got != tt.want {
+			t.Errorf("formatUnits(%d, %d) = %q, want %q", tt.value, tt.decimals, got, tt.want)
+		}
+	}
+}
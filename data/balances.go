@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	"github.com/ABT-Tech-Limited/alchemy-go/node"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// NativeAssetAddress is the pseudo contract address used for the native
+// asset in AllBalancesResponse.
+const NativeAssetAddress types.Address = "0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"
+
+// NativeCurrency describes a network's native currency.
+type NativeCurrency struct {
+	// Symbol is the currency symbol, e.g. "ETH".
+	Symbol string
+	// Decimals is the number of decimals.
+	Decimals int
+}
+
+// WithNativeCurrency sets the native currency reported by GetAllBalances and
+// returns the client. alchemy.New sets it from Network.NativeCurrency; it
+// defaults to ETH with 18 decimals. It must be called before the client is
+// used concurrently.
+func (c *Client) WithNativeCurrency(currency NativeCurrency) *Client {
+	if currency.Symbol != "" {
+		c.native = currency
+	}
+	return c
+}
+
+// AllBalancesOptions configures GetAllBalances.
+type AllBalancesOptions struct {
+	// PageKey continues the ERC20 scan from a previous response.
+	// The native balance is only included on the first page.
+	PageKey string
+	// MaxCount is the maximum number of ERC20 balances per page.
+	MaxCount int
+}
+
+// AssetBalance is a native or ERC20 balance in AllBalancesResponse.
+type AssetBalance struct {
+	// ContractAddress is the token contract address, or NativeAssetAddress
+	// for the native asset.
+	ContractAddress types.Address
+	// IsNative is true for the native asset entry.
+	IsNative bool
+	// Symbol is the native currency symbol; empty for ERC20 entries.
+	Symbol string
+	// Decimals is the native currency decimals; nil for ERC20 entries.
+	Decimals *int
+	// Balance is the raw balance, or nil if Error is set.
+	Balance *big.Int
+	// Error is the error message if the balance couldn't be fetched.
+	Error *string
+}
+
+// AllBalancesResponse is the combined native and ERC20 balances of an address.
+type AllBalancesResponse struct {
+	// Address is the queried address.
+	Address types.Address
+	// Balances lists the native asset first (on the first page), followed by ERC20 tokens.
+	Balances []AssetBalance
+	// PageKey is the pagination key of the ERC20 portion.
+	PageKey string
+}
+
+// HasMore returns true if there are more ERC20 balances available.
+func (r *AllBalancesResponse) HasMore() bool {
+	return r.PageKey != ""
+}
+
+// Native returns the native asset entry, or nil if it is not on this page.
+func (r *AllBalancesResponse) Native() *AssetBalance {
+	for i := range r.Balances {
+		if r.Balances[i].IsNative {
+			return &r.Balances[i]
+		}
+	}
+	return nil
+}
+
+// GetAllBalances retrieves the native balance and the ERC20 token balances
+// of an address in a single JSON-RPC batch (alchemy_getTokenBalances and
+// eth_getBalance). The native asset is returned as a pseudo-entry with the
+// network's currency symbol. Pass the returned PageKey in opts to fetch the
+// next page of ERC20 balances.
+func (c *Client) GetAllBalances(ctx context.Context, address types.Address, opts *AllBalancesOptions) (*AllBalancesResponse, error) {
+	if opts == nil {
+		opts = &AllBalancesOptions{}
+	}
+
+	params := NewTokenBalancesParams(address).
+		SetTokenSpec(TokenSpecERC20).
+		SetPageKey(opts.PageKey).
+		SetMaxCount(opts.MaxCount)
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	var tokens TokenBalancesResponse
+	var native types.Quantity
+	calls := []client.BatchCall{{
+		Method: "alchemy_getTokenBalances",
+		Params: params.rpcParams(),
+		Result: &tokens,
+	}}
+	withNative := opts.PageKey == ""
+	if withNative {
+		calls = append(calls, client.BatchCall{
+			Method: "eth_getBalance",
+			Params: []interface{}{address.String(), node.BlockLatest.String()},
+			Result: &native,
+		})
+	}
+
+	results, err := c.rpc.BatchCall(ctx, calls)
+	if err != nil {
+		return nil, err
+	}
+	if results[0].Error != nil {
+		return nil, fmt.Errorf("failed to get token balances for %s: %w", address, results[0].Error)
+	}
+
+	resp := &AllBalancesResponse{
+		Address:  address,
+		Balances: make([]AssetBalance, 0, len(tokens.TokenBalances)+1),
+		PageKey:  tokens.PageKey,
+	}
+
+	if withNative {
+		decimals := c.native.Decimals
+		entry := AssetBalance{
+			ContractAddress: NativeAssetAddress,
+			IsNative:        true,
+			Symbol:          c.native.Symbol,
+			Decimals:        &decimals,
+		}
+		if results[1].Error != nil {
+			msg := results[1].Error.Error()
+			entry.Error = &msg
+		} else {
+			entry.Balance = native.BigInt()
+		}
+		resp.Balances = append(resp.Balances, entry)
+	}
+
+	for _, tb := range tokens.TokenBalances {
+		entry := AssetBalance{ContractAddress: tb.ContractAddress, Error: tb.Error}
+		if tb.Error == nil && tb.TokenBalance != nil {
+			balance, err := parseBigInt(*tb.TokenBalance)
+			if err != nil {
+				msg := err.Error()
+				entry.Error = &msg
+			} else {
+				entry.Balance = balance
+			}
+		}
+		resp.Balances = append(resp.Balances, entry)
+	}
+
+	return resp, nil
+}
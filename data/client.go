@@ -2,22 +2,45 @@
 package data
 
 import (
+	"strings"
+
 	"github.com/ABT-Tech-Limited/alchemy-go/client"
 )
 
 // Client is the Data API client.
+// It is safe for concurrent use by multiple goroutines once configured; the
+// With* and Set* methods must be called before the client is shared.
 type Client struct {
-	http   *client.HTTPClient
-	rpc    *client.JSONRPCClient
-	nftURL string
+	http         *client.HTTPClient
+	rpc          *client.JSONRPCClient
+	nftURL       string
+	portfolioURL string
+	apiKey       string
+	gateways     *GatewayOptions
+	native       NativeCurrency
+	labeler      AddressLabeler
+
+	metadataCache *tokenMetadataCache
 }
 
 // NewClient creates a new Data API client.
+// NFT API requests use the API key configured on httpClient; use
+// NewClientWithAPIKey to set it explicitly.
 func NewClient(httpClient *client.HTTPClient, rpc *client.JSONRPCClient, nftURL string) *Client {
+	return NewClientWithAPIKey(httpClient, rpc, nftURL, httpClient.APIKey())
+}
+
+// NewClientWithAPIKey creates a new Data API client.
+// NFT API requests are sent to nftURL/apiKey/<method>. Pass an empty apiKey
+// if nftURL already includes the key.
+func NewClientWithAPIKey(httpClient *client.HTTPClient, rpc *client.JSONRPCClient, nftURL, apiKey string) *Client {
 	return &Client{
-		http:   httpClient,
-		rpc:    rpc,
-		nftURL: nftURL,
+		http:         httpClient,
+		rpc:          rpc,
+		nftURL:       strings.TrimSuffix(nftURL, "/"),
+		portfolioURL: DefaultPortfolioURL,
+		apiKey:       apiKey,
+		native:       NativeCurrency{Symbol: "ETH", Decimals: 18},
 	}
 }
 
@@ -30,3 +53,17 @@ func (c *Client) HTTP() *client.HTTPClient {
 func (c *Client) RPC() *client.JSONRPCClient {
 	return c.rpc
 }
+
+// NFTURL returns the NFT API base URL, without the API key.
+func (c *Client) NFTURL() string {
+	return c.nftURL
+}
+
+// nftEndpoint builds the NFT API URL for the given method.
+func (c *Client) nftEndpoint(method string) string {
+	url := c.nftURL
+	if c.apiKey != "" {
+		url = url + "/" + c.apiKey
+	}
+	return url + "/" + method
+}
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// testServer records the paths of the requests it serves.
+type testServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	paths []string
+}
+
+// newTestServer starts a server that records request paths and delegates to
+// handler. It is closed when the test ends.
+func newTestServer(t *testing.T, handler http.HandlerFunc) *testServer {
+	t.Helper()
+	s := &testServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.paths = append(s.paths, r.URL.Path)
+		s.mu.Unlock()
+		handler(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Paths returns the paths requested so far.
+func (s *testServer) Paths() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.paths...)
+}
+
+// newTestHTTPClient creates an HTTP client without retries.
+func newTestHTTPClient(baseURL, apiKey string) *client.HTTPClient {
+	return client.NewHTTPClient(client.HTTPClientConfig{
+		BaseURL: baseURL,
+		APIKey:  apiKey,
+	})
+}
+
+// newTestClient creates a Data API client whose NFT API and JSON-RPC
+// requests go to srv.
+func newTestClient(srv *testServer) *Client {
+	httpClient := newTestHTTPClient(srv.URL+"/v2/test-key", "test-key")
+	return NewClientWithAPIKey(httpClient, client.NewJSONRPCClient(httpClient), srv.URL+"/nft/v3", "test-key")
+}
+
+const testNFTMetadata = `{"contract":{"address":"0x0000000000000000000000000000000000000001"},"tokenId":"1"}`
+
+func TestNFTEndpointWithCustomBaseURL(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testNFTMetadata))
+	})
+
+	tests := []struct {
+		name   string
+		client *Client
+		want   string
+	}{
+		{
+			name:   "explicit key",
+			client: NewClientWithAPIKey(newTestHTTPClient("https://proxy.example.com/eth/", "other"), nil, srv.URL+"/nft/v3/", "nft-key"),
+			want:   "/nft/v3/nft-key/getNFTMetadata",
+		},
+		{
+			name:   "key from HTTP client",
+			client: NewClient(newTestHTTPClient("https://proxy.example.com/eth/", "http-key"), nil, srv.URL+"/nft/v3"),
+			want:   "/nft/v3/http-key/getNFTMetadata",
+		},
+		{
+			name:   "key in URL",
+			client: NewClientWithAPIKey(newTestHTTPClient("https://proxy.example.com/eth/", "other"), nil, srv.URL+"/nft/v3/url-key", ""),
+			want:   "/nft/v3/url-key/getNFTMetadata",
+		},
+	}
+
+	contract := types.MustParseAddress("0x0000000000000000000000000000000000000001")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(srv.Paths())
+			if _, err := tt.client.GetNFTMetadata(context.Background(), NewNFTMetadataParams(contract, "1")); err != nil {
+				t.Fatalf("GetNFTMetadata() error = %v", err)
+			}
+			paths := srv.Paths()
+			if len(paths) != before+1 || paths[before] != tt.want {
+				t.Errorf("requested %v, want %q", paths[before:], tt.want)
+			}
+		})
+	}
+}
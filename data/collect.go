@@ -0,0 +1,110 @@
+package data
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultCollectMaxItems is the item ceiling applied by CollectAll when
+// CollectOptions.MaxItems is not set.
+const DefaultCollectMaxItems = 100000
+
+// CollectOptions controls how CollectAll gathers results from an iterator.
+type CollectOptions struct {
+	// MaxItems is the maximum number of items to collect (default: DefaultCollectMaxItems).
+	MaxItems int
+	// MaxPages is the maximum number of pages to fetch (0 means no limit).
+	MaxPages int
+	// OnPage is called after each page has been consumed with the zero-based
+	// page index and the number of items collected so far.
+	OnPage func(pageIndex, itemsSoFar int)
+}
+
+// withDefaults returns a copy of the options with default values applied.
+func (o *CollectOptions) withDefaults() CollectOptions {
+	var opts CollectOptions
+	if o != nil {
+		opts = *o
+	}
+	if opts.MaxItems <= 0 {
+		opts.MaxItems = DefaultCollectMaxItems
+	}
+	return opts
+}
+
+// collectState is the state of a CollectAll call in progress. The iterator
+// holds it while CollectAll runs, so that page fetches use the call's
+// context, stop at its page limit and report each consumed page. It is
+// guarded by the iterator's mutex.
+type collectState struct {
+	ctx      context.Context
+	maxPages int
+	onPage   bool
+	limited  bool
+	fetches  int
+	returned int
+	reports  [][2]int
+}
+
+// newCollectState creates the state for a CollectAll call with options o.
+func newCollectState(ctx context.Context, o CollectOptions) *collectState {
+	return &collectState{ctx: ctx, maxPages: o.MaxPages, onPage: o.OnPage != nil}
+}
+
+// context returns the context for page fetches: the CollectAll context
+// while a call is in progress, def otherwise.
+func (s *collectState) context(def context.Context) context.Context {
+	if s == nil {
+		return def
+	}
+	return s.ctx
+}
+
+// allowFetch reports whether another page may be fetched once pages pages
+// have been fetched, and records when the page limit stops the iteration.
+func (s *collectState) allowFetch(pages int) bool {
+	if s == nil || s.maxPages <= 0 || pages < s.maxPages {
+		return true
+	}
+	s.limited = true
+	return false
+}
+
+// fetched records that a page was fetched, bringing the total to pages.
+// The page before it has then been consumed.
+func (s *collectState) fetched(pages int) {
+	if s == nil {
+		return
+	}
+	s.fetches++
+	if s.onPage && pages >= 2 {
+		s.reports = append(s.reports, [2]int{pages - 2, s.returned})
+	}
+}
+
+// count records that an item was returned to the caller.
+func (s *collectState) count() {
+	if s != nil {
+		s.returned++
+	}
+}
+
+// finish records that the last of pages pages has been consumed.
+func (s *collectState) finish(pages int) {
+	if s.onPage && pages > 0 && (s.fetches > 0 || s.returned > 0) {
+		s.reports = append(s.reports, [2]int{pages - 1, s.returned})
+	}
+}
+
+// drain passes the recorded pages to onPage. mu is the iterator's mutex; it
+// is not held while onPage runs.
+func (s *collectState) drain(mu sync.Locker, onPage func(pageIndex, itemsSoFar int)) {
+	mu.Lock()
+	reports := s.reports
+	s.reports = nil
+	mu.Unlock()
+
+	for _, r := range reports {
+		onPage(r[0], r[1])
+	}
+}
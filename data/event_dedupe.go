@@ -0,0 +1,145 @@
+package data
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Event deduplication defaults.
+const (
+	// DefaultEventDedupeTTL is how long a handled event ID is remembered.
+	DefaultEventDedupeTTL = 24 * time.Hour
+	// DefaultEventDedupeSize is the capacity of the default in-memory store.
+	DefaultEventDedupeSize = 100000
+)
+
+// EventStore records handled webhook event IDs for an EventDeduper.
+// Implementations must be safe for concurrent use; back it with a shared
+// store such as Redis to deduplicate across processes.
+type EventStore interface {
+	// Seen reports whether id was marked and has not expired.
+	Seen(ctx context.Context, id string) (bool, error)
+	// Mark records id as handled for ttl.
+	Mark(ctx context.Context, id string, ttl time.Duration) error
+}
+
+// MemoryEventStore is an in-memory EventStore holding at most size IDs,
+// evicting the least recently marked ID when full. It is safe for
+// concurrent use.
+type MemoryEventStore struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+// eventEntry is a marked event ID.
+type eventEntry struct {
+	id        string
+	expiresAt time.Time
+}
+
+// NewMemoryEventStore creates a MemoryEventStore holding at most size IDs.
+// Non-positive values use DefaultEventDedupeSize.
+func NewMemoryEventStore(size int) *MemoryEventStore {
+	if size <= 0 {
+		size = DefaultEventDedupeSize
+	}
+	return &MemoryEventStore{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Seen implements EventStore.
+func (s *MemoryEventStore) Seen(_ context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.entries[id]
+	if !ok {
+		return false, nil
+	}
+	if !time.Now().Before(el.Value.(*eventEntry).expiresAt) {
+		s.removeElement(el)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Mark implements EventStore.
+func (s *MemoryEventStore) Mark(_ context.Context, id string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := &eventEntry{id: id, expiresAt: time.Now().Add(ttl)}
+	if el, ok := s.entries[id]; ok {
+		el.Value = entry
+		s.lru.MoveToFront(el)
+		return nil
+	}
+
+	s.entries[id] = s.lru.PushFront(entry)
+	for s.lru.Len() > s.size {
+		s.removeElement(s.lru.Back())
+	}
+	return nil
+}
+
+// Len returns the number of stored IDs, including expired ones not yet evicted.
+func (s *MemoryEventStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lru.Len()
+}
+
+// removeElement removes an entry. The caller must hold s.mu.
+func (s *MemoryEventStore) removeElement(el *list.Element) {
+	s.lru.Remove(el)
+	delete(s.entries, el.Value.(*eventEntry).id)
+}
+
+// EventDeduper detects redelivered webhook events by their ID. Alchemy
+// redelivers events whose delivery timed out or failed, so an event may
+// arrive more than once.
+//
+// An event is marked only after it has been handled, so a failed event is
+// processed again on redelivery. Two deliveries of the same event that
+// arrive concurrently may both be processed.
+type EventDeduper struct {
+	store EventStore
+	ttl   time.Duration
+}
+
+// NewEventDeduper creates an EventDeduper remembering event IDs in store for
+// ttl. A nil store uses NewMemoryEventStore(DefaultEventDedupeSize); a
+// non-positive ttl uses DefaultEventDedupeTTL.
+func NewEventDeduper(store EventStore, ttl time.Duration) *EventDeduper {
+	if store == nil {
+		store = NewMemoryEventStore(DefaultEventDedupeSize)
+	}
+	if ttl <= 0 {
+		ttl = DefaultEventDedupeTTL
+	}
+	return &EventDeduper{store: store, ttl: ttl}
+}
+
+// IsDuplicate reports whether event was already marked as handled.
+// Events without an ID are never duplicates.
+func (d *EventDeduper) IsDuplicate(ctx context.Context, event *WebhookEvent) (bool, error) {
+	if event == nil || event.ID == "" {
+		return false, nil
+	}
+	return d.store.Seen(ctx, event.ID)
+}
+
+// MarkHandled records event as handled. Events without an ID are ignored.
+func (d *EventDeduper) MarkHandled(ctx context.Context, event *WebhookEvent) error {
+	if event == nil || event.ID == "" {
+		return nil
+	}
+	return d.store.Mark(ctx, event.ID, d.ttl)
+}
@@ -0,0 +1,174 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+// GetTransactionHistory retrieves a page of complete transactions (gas,
+// status, logs and internal calls) sent or received by an address, using the
+// Portfolio API's transaction history endpoint.
+// Use GetTransactionHistoryIterator to page through all results.
+func (c *Client) GetTransactionHistory(ctx context.Context, params *TransactionHistoryParams) (*TransactionHistoryResponse, error) {
+	if params == nil {
+		return nil, fmt.Errorf("%w: params are required", sdkerrors.ErrInvalidParameter)
+	}
+	if err := validatePortfolioAddresses(params.Addresses, MaxTransactionHistoryAddresses, MaxTransactionHistoryNetworks); err != nil {
+		return nil, err
+	}
+	if params.Limit < 0 || params.Limit > MaxTransactionHistoryLimit {
+		return nil, fmt.Errorf("%w: limit must be between 0 and %d, got %d", sdkerrors.ErrInvalidParameter, MaxTransactionHistoryLimit, params.Limit)
+	}
+	if params.Before != "" && params.After != "" {
+		return nil, fmt.Errorf("%w: before and after cannot both be set", sdkerrors.ErrInvalidParameter)
+	}
+
+	respBody, err := c.http.PostURL(ctx, c.portfolioEndpoint("transactions/history/by-address"), params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TransactionHistoryResponse
+	if err := c.http.Unmarshal(respBody, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTransactionHistoryIterator returns an iterator that pages forwards
+// through an address's transaction history using the After cursor.
+// The params are copied; the caller's struct is not modified.
+func (c *Client) GetTransactionHistoryIterator(ctx context.Context, params *TransactionHistoryParams) *TransactionHistoryIterator {
+	paramsCopy := *params
+	paramsCopy.Addresses = append([]PortfolioAddress(nil), params.Addresses...)
+	return &TransactionHistoryIterator{
+		client: c,
+		params: &paramsCopy,
+		ctx:    ctx,
+	}
+}
+
+// TransactionHistoryIterator iterates through transaction history with
+// cursor-based pagination.
+type TransactionHistoryIterator struct {
+	client  *Client
+	params  *TransactionHistoryParams
+	ctx     context.Context
+	current *TransactionHistoryResponse
+	index   int
+	done    bool
+	err     error
+	mu      sync.Mutex
+}
+
+// Next returns the next transaction in the iteration.
+// Returns nil when there are no more transactions.
+func (it *TransactionHistoryIterator) Next() (*HistoryTransaction, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	if it.done {
+		return nil, nil
+	}
+
+	if it.current == nil {
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return nil, err
+		}
+	}
+
+	if it.index < len(it.current.Transactions) {
+		tx := &it.current.Transactions[it.index]
+		it.index++
+		return tx, nil
+	}
+
+	if !it.current.HasMore() {
+		it.done = true
+		return nil, nil
+	}
+
+	it.params.Before = ""
+	it.params.After = it.current.After
+	if err := it.fetchNext(); err != nil {
+		it.err = err
+		return nil, err
+	}
+
+	if len(it.current.Transactions) == 0 {
+		it.done = true
+		return nil, nil
+	}
+
+	tx := &it.current.Transactions[0]
+	it.index = 1
+	return tx, nil
+}
+
+// HasNext returns true if there are more transactions to iterate.
+func (it *TransactionHistoryIterator) HasNext() bool {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.current != nil && it.index < len(it.current.Transactions) {
+		return true
+	}
+
+	if it.current != nil {
+		return it.current.HasMore()
+	}
+
+	return true
+}
+
+// Error returns any error encountered during iteration.
+func (it *TransactionHistoryIterator) Error() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// Collect returns all remaining transactions as a slice.
+func (it *TransactionHistoryIterator) Collect() ([]HistoryTransaction, error) {
+	return it.CollectN(0)
+}
+
+// CollectN returns up to n transactions. If n is zero or negative, all remaining transactions are returned.
+func (it *TransactionHistoryIterator) CollectN(n int) ([]HistoryTransaction, error) {
+	var txs []HistoryTransaction
+
+	for n <= 0 || len(txs) < n {
+		tx, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			break
+		}
+		txs = append(txs, *tx)
+	}
+
+	return txs, nil
+}
+
+func (it *TransactionHistoryIterator) fetchNext() error {
+	result, err := it.client.GetTransactionHistory(it.ctx, it.params)
+	if err != nil {
+		return err
+	}
+	it.current = result
+	it.index = 0
+	return nil
+}
@@ -0,0 +1,188 @@
+package data
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// Transaction history request limits.
+const (
+	// MaxTransactionHistoryAddresses is the maximum number of addresses per request.
+	MaxTransactionHistoryAddresses = 1
+	// MaxTransactionHistoryNetworks is the maximum number of networks per address.
+	MaxTransactionHistoryNetworks = 2
+	// MaxTransactionHistoryLimit is the maximum number of transactions per page.
+	MaxTransactionHistoryLimit = 50
+)
+
+// TransactionHistoryParams represents the parameters for the transaction
+// history by-address endpoint. Pages are selected with the Before and After
+// cursors returned by a previous response.
+type TransactionHistoryParams struct {
+	// Addresses are the address and networks to query.
+	Addresses []PortfolioAddress `json:"addresses"`
+	// Before returns the page before this cursor.
+	Before string `json:"before,omitempty"`
+	// After returns the page after this cursor.
+	After string `json:"after,omitempty"`
+	// Limit is the number of transactions per page (max 50).
+	Limit int `json:"limit,omitempty"`
+}
+
+// NewTransactionHistoryParams creates new TransactionHistoryParams for an
+// address on the given networks.
+func NewTransactionHistoryParams(address types.Address, networks ...string) *TransactionHistoryParams {
+	return &TransactionHistoryParams{
+		Addresses: []PortfolioAddress{{Address: address, Networks: networks}},
+	}
+}
+
+// SetBefore sets the cursor to page backwards from.
+func (p *TransactionHistoryParams) SetBefore(cursor string) *TransactionHistoryParams {
+	p.Before = cursor
+	return p
+}
+
+// SetAfter sets the cursor to page forwards from.
+func (p *TransactionHistoryParams) SetAfter(cursor string) *TransactionHistoryParams {
+	p.After = cursor
+	return p
+}
+
+// SetLimit sets the number of transactions per page.
+func (p *TransactionHistoryParams) SetLimit(limit int) *TransactionHistoryParams {
+	p.Limit = limit
+	return p
+}
+
+// TransactionHistoryResponse represents a page of transaction history.
+type TransactionHistoryResponse struct {
+	// Transactions is the list of transactions, newest first.
+	Transactions []HistoryTransaction `json:"transactions"`
+	// Before is the cursor of the previous page.
+	Before string `json:"before,omitempty"`
+	// After is the cursor of the next page.
+	After string `json:"after,omitempty"`
+	// TotalCount is the total number of transactions.
+	TotalCount int `json:"totalCount"`
+}
+
+// HasMore returns true if there are more results available.
+func (r *TransactionHistoryResponse) HasMore() bool {
+	return r.After != ""
+}
+
+// HistoryTransaction is a complete transaction record as returned by the
+// transaction history endpoint. Amounts are decimal strings in wei.
+type HistoryTransaction struct {
+	// Network is the network the transaction is on.
+	Network string `json:"network"`
+	// Hash is the transaction hash.
+	Hash types.Hash `json:"hash"`
+	// Timestamp is the block timestamp (RFC 3339).
+	Timestamp string `json:"timeStamp"`
+	// BlockNumber is the block number.
+	BlockNumber types.Quantity `json:"blockNumber"`
+	// BlockHash is the block hash.
+	BlockHash types.Hash `json:"blockHash"`
+	// Nonce is the sender nonce.
+	Nonce types.Quantity `json:"nonce"`
+	// TransactionIndex is the index of the transaction in the block.
+	TransactionIndex types.Quantity `json:"transactionIndex"`
+	// FromAddress is the sender.
+	FromAddress types.Address `json:"fromAddress"`
+	// ToAddress is the recipient, or nil for contract creation.
+	ToAddress *types.Address `json:"toAddress,omitempty"`
+	// ContractAddress is the created contract, if any.
+	ContractAddress *types.Address `json:"contractAddress,omitempty"`
+	// Value is the value transferred.
+	Value string `json:"value"`
+	// CumulativeGasUsed is the gas used in the block up to and including this transaction.
+	CumulativeGasUsed string `json:"cumulativeGasUsed"`
+	// EffectiveGasPrice is the gas price paid.
+	EffectiveGasPrice string `json:"effectiveGasPrice"`
+	// GasUsed is the gas used by the transaction.
+	GasUsed string `json:"gasUsed"`
+	// Status is the execution status (1 for success, 0 for failure), if reported.
+	Status *types.Quantity `json:"status,omitempty"`
+	// MethodID is the 4-byte function selector, if reported.
+	MethodID *string `json:"methodId,omitempty"`
+	// Logs are the event logs emitted by the transaction.
+	Logs []HistoryLog `json:"logs,omitempty"`
+	// InternalTxns are the internal calls made by the transaction.
+	InternalTxns []HistoryInternalTransaction `json:"internalTxns,omitempty"`
+}
+
+// Time returns the parsed block timestamp.
+func (t *HistoryTransaction) Time() (time.Time, error) {
+	return time.Parse(time.RFC3339, t.Timestamp)
+}
+
+// ValueBigInt returns the value transferred as a big.Int.
+func (t *HistoryTransaction) ValueBigInt() (*big.Int, error) {
+	return parseBigInt(t.Value)
+}
+
+// Fee returns the transaction fee in wei (gasUsed * effectiveGasPrice).
+func (t *HistoryTransaction) Fee() (*big.Int, error) {
+	gasUsed, err := parseBigInt(t.GasUsed)
+	if err != nil {
+		return nil, err
+	}
+	price, err := parseBigInt(t.EffectiveGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	return gasUsed.Mul(gasUsed, price), nil
+}
+
+// Succeeded reports whether the transaction succeeded. It returns true if
+// the status is not reported.
+func (t *HistoryTransaction) Succeeded() bool {
+	return t.Status == nil || t.Status.Uint64() == 1
+}
+
+// HistoryLog is a summary of an event log in a HistoryTransaction.
+type HistoryLog struct {
+	// ContractAddress is the emitting contract.
+	ContractAddress types.Address `json:"contractAddress"`
+	// LogIndex is the index of the log in the block.
+	LogIndex types.Quantity `json:"logIndex"`
+	// Data is the non-indexed log data.
+	Data types.Data `json:"data"`
+	// Removed is true if the log was removed by a reorg.
+	Removed bool `json:"removed"`
+	// Topics are the indexed log topics.
+	Topics []types.Hash `json:"topics"`
+}
+
+// HistoryInternalTransaction is an internal call in a HistoryTransaction.
+type HistoryInternalTransaction struct {
+	// Type is the call type, e.g. CALL, DELEGATECALL or CREATE.
+	Type string `json:"type"`
+	// FromAddress is the caller.
+	FromAddress types.Address `json:"fromAddress"`
+	// ToAddress is the callee.
+	ToAddress *types.Address `json:"toAddress,omitempty"`
+	// Value is the value transferred, in wei.
+	Value string `json:"value"`
+	// Gas is the gas provided to the call.
+	Gas string `json:"gas"`
+	// GasUsed is the gas used by the call.
+	GasUsed string `json:"gasUsed"`
+	// Input is the call data.
+	Input types.Data `json:"input"`
+	// Output is the return data.
+	Output types.Data `json:"output"`
+	// Error is the error message if the call failed.
+	Error *string `json:"error,omitempty"`
+	// RevertReason is the decoded revert reason, if any.
+	RevertReason *string `json:"revertReason,omitempty"`
+}
+
+// ValueBigInt returns the value transferred as a big.Int.
+func (t *HistoryInternalTransaction) ValueBigInt() (*big.Int, error) {
+	return parseBigInt(t.Value)
+}
@@ -0,0 +1,276 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// iteratorPages is the number of pages served by the fake paginated
+// endpoints, each holding two items.
+const iteratorPages = 3
+
+// pageItems returns the item IDs on the page reached with pageKey and the key
+// of the following page.
+func pageItems(pageKey string) ([]string, string) {
+	page := 1
+	if pageKey != "" {
+		_, _ = fmt.Sscanf(pageKey, "page%d", &page)
+	}
+	next := ""
+	if page < iteratorPages {
+		next = fmt.Sprintf("page%d", page+1)
+	}
+	return []string{fmt.Sprint(2*page - 1), fmt.Sprint(2 * page)}, next
+}
+
+// pagedIterator adapts the NFT and transfer iterators for the shared checks
+// in testIteratorParity. Items are reduced to their IDs.
+type pagedIterator struct {
+	next       func() (string, error)
+	collect    func() ([]string, error)
+	collectN   func(n int) ([]string, error)
+	reset      func()
+	totalCount func() int // nil if the iterator has no TotalCount
+}
+
+// testIteratorParity checks the Reset, CollectN and TotalCount semantics
+// that every paginated iterator must share. requests reports the number of
+// page requests served so far.
+func testIteratorParity(t *testing.T, it pagedIterator, requests func() int64) {
+	t.Helper()
+	all := []string{"1", "2", "3", "4", "5", "6"}
+
+	if it.totalCount != nil {
+		if n := it.totalCount(); n != len(all) {
+			t.Errorf("TotalCount() before iterating = %d, want %d", n, len(all))
+		}
+		if n := requests(); n != 1 {
+			t.Errorf("TotalCount() made %d requests, want 1", n)
+		}
+		if id, err := it.next(); err != nil || id != "1" {
+			t.Errorf("Next() after TotalCount() = %q, %v; want first item", id, err)
+		}
+		if n := requests(); n != 1 {
+			t.Errorf("Next() refetched the first page: %d requests, want 1", n)
+		}
+		it.reset()
+	}
+
+	got, err := it.collectN(3)
+	if err != nil || !reflect.DeepEqual(got, all[:3]) {
+		t.Fatalf("CollectN(3) = %v, %v; want %v", got, err, all[:3])
+	}
+	got, err = it.collectN(0)
+	if err != nil || len(got) != 0 {
+		t.Errorf("CollectN(0) = %v, %v; want none", got, err)
+	}
+	got, err = it.collectN(10)
+	if err != nil || !reflect.DeepEqual(got, all[3:]) {
+		t.Errorf("CollectN(10) = %v, %v; want remaining %v", got, err, all[3:])
+	}
+	got, err = it.collectN(1)
+	if err != nil || len(got) != 0 {
+		t.Errorf("CollectN(1) when exhausted = %v, %v; want none", got, err)
+	}
+
+	it.reset()
+	got, err = it.collect()
+	if err != nil || !reflect.DeepEqual(got, all) {
+		t.Errorf("Collect() after Reset() = %v, %v; want %v", got, err, all)
+	}
+}
+
+func TestNFTsForOwnerIteratorParity(t *testing.T) {
+	var requests atomic.Int64
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		ids, next := pageItems(r.URL.Query().Get("pageKey"))
+		nfts := make([]string, len(ids))
+		for i, id := range ids {
+			nfts[i] = fmt.Sprintf(`{"contract":{"address":"0x0000000000000000000000000000000000000001"},"tokenId":%q}`, id)
+		}
+		fmt.Fprintf(w, `{"ownedNfts":[%s],"totalCount":%d,"pageKey":%q}`, strings.Join(nfts, ","), 2*iteratorPages, next)
+	})
+	owner := types.MustParseAddress("0x00000000000000000000000000000000000000aa")
+	it := newTestClient(srv).GetNFTsForOwnerIterator(context.Background(), NewNFTsForOwnerParams(owner))
+
+	ids := func(nfts []OwnedNFT, err error) ([]string, error) {
+		out := make([]string, len(nfts))
+		for i := range nfts {
+			out[i] = nfts[i].TokenID
+		}
+		return out, err
+	}
+	testIteratorParity(t, pagedIterator{
+		next: func() (string, error) {
+			nft, err := it.Next()
+			if nft == nil {
+				return "", err
+			}
+			return nft.TokenID, err
+		},
+		collect:    func() ([]string, error) { return ids(it.Collect()) },
+		collectN:   func(n int) ([]string, error) { return ids(it.CollectN(n)) },
+		reset:      it.Reset,
+		totalCount: it.TotalCount,
+	}, requests.Load)
+}
+
+func TestAssetTransfersIteratorParity(t *testing.T) {
+	var requests atomic.Int64
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var req client.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		params, _ := req.Params[0].(map[string]interface{})
+		pageKey, _ := params["pageKey"].(string)
+
+		ids, next := pageItems(pageKey)
+		transfers := make([]map[string]interface{}, len(ids))
+		for i, id := range ids {
+			transfers[i] = map[string]interface{}{"uniqueId": id, "hash": "0x" + id}
+		}
+		result := map[string]interface{}{"transfers": transfers}
+		if next != "" {
+			result["pageKey"] = next
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	})
+	it := newTestClient(srv).GetAssetTransfersIterator(context.Background(), NewAssetTransfersParams())
+
+	ids := func(transfers []AssetTransfer, err error) ([]string, error) {
+		out := make([]string, len(transfers))
+		for i := range transfers {
+			out[i] = transfers[i].UniqueID
+		}
+		return out, err
+	}
+	testIteratorParity(t, pagedIterator{
+		next: func() (string, error) {
+			transfer, err := it.Next()
+			if transfer == nil {
+				return "", err
+			}
+			return transfer.UniqueID, err
+		},
+		collect:  func() ([]string, error) { return ids(it.Collect()) },
+		collectN: func(n int) ([]string, error) { return ids(it.CollectN(n)) },
+		reset:    it.Reset,
+	}, requests.Load)
+}
+
+func TestCollectAllCanceled(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	c := newTestClient(srv)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	owner := types.MustParseAddress("0x00000000000000000000000000000000000000aa")
+	_, _, err := c.GetNFTsForOwnerIterator(ctx, NewNFTsForOwnerParams(owner)).CollectAll(ctx, nil)
+	if !errors.Is(err, sdkerrors.ErrContextCanceled) {
+		t.Errorf("NFTsForOwnerIterator.CollectAll() error = %v, want ErrContextCanceled", err)
+	}
+	_, _, err = c.GetAssetTransfersIterator(ctx, NewAssetTransfersParams()).CollectAll(ctx, nil)
+	if !errors.Is(err, sdkerrors.ErrContextCanceled) {
+		t.Errorf("AssetTransfersIterator.CollectAll() error = %v, want ErrContextCanceled", err)
+	}
+}
+
+func TestCollectAllPagesWithDeduplicate(t *testing.T) {
+	// The tail of the second page repeats a transfer from the first.
+	pages := map[string][]string{"": {"1", "2"}, "p2": {"3", "2"}, "p3": {"4", "5"}}
+	next := map[string]string{"": "p2", "p2": "p3"}
+	var requests atomic.Int64
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var req client.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		params, _ := req.Params[0].(map[string]interface{})
+		pageKey, _ := params["pageKey"].(string)
+
+		var transfers []map[string]interface{}
+		for _, id := range pages[pageKey] {
+			transfers = append(transfers, map[string]interface{}{"uniqueId": id})
+		}
+		result := map[string]interface{}{"transfers": transfers}
+		if next[pageKey] != "" {
+			result["pageKey"] = next[pageKey]
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	})
+	c := newTestClient(srv)
+
+	var reports [][2]int
+	opts := &CollectOptions{OnPage: func(pageIndex, itemsSoFar int) {
+		reports = append(reports, [2]int{pageIndex, itemsSoFar})
+	}}
+	it := c.GetAssetTransfersIterator(context.Background(), NewAssetTransfersParams()).SetDeduplicate(true)
+	transfers, truncated, err := it.CollectAll(context.Background(), opts)
+	if err != nil || truncated || len(transfers) != 5 {
+		t.Fatalf("CollectAll() = %d transfers, truncated = %v, error = %v; want 5", len(transfers), truncated, err)
+	}
+	if want := [][2]int{{0, 2}, {1, 3}, {2, 5}}; !reflect.DeepEqual(reports, want) {
+		t.Errorf("OnPage calls = %v, want %v", reports, want)
+	}
+
+	requests.Store(0)
+	opts.MaxPages = 2
+	reports = nil
+	it = c.GetAssetTransfersIterator(context.Background(), NewAssetTransfersParams()).SetDeduplicate(true)
+	transfers, truncated, err = it.CollectAll(context.Background(), opts)
+	if err != nil || !truncated || len(transfers) != 3 || requests.Load() != 2 {
+		t.Errorf("CollectAll(MaxPages 2) = %d transfers in %d requests, truncated = %v, error = %v; want 3 in 2, truncated",
+			len(transfers), requests.Load(), truncated, err)
+	}
+	if want := [][2]int{{0, 2}, {1, 3}}; !reflect.DeepEqual(reports, want) {
+		t.Errorf("OnPage calls with MaxPages 2 = %v, want %v", reports, want)
+	}
+}
+
+func TestCollectAllCancelsInFlightFetch(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+	c := newTestClient(srv)
+	owner := types.MustParseAddress("0x00000000000000000000000000000000000000aa")
+
+	// The iterators are built with a context that is never cancelled; only
+	// the context passed to CollectAll can abort the page requests.
+	collectors := map[string]func(ctx context.Context) error{
+		"NFTsForOwnerIterator": func(ctx context.Context) error {
+			_, _, err := c.GetNFTsForOwnerIterator(context.Background(), NewNFTsForOwnerParams(owner)).CollectAll(ctx, nil)
+			return err
+		},
+		"AssetTransfersIterator": func(ctx context.Context) error {
+			_, _, err := c.GetAssetTransfersIterator(context.Background(), NewAssetTransfersParams()).CollectAll(ctx, nil)
+			return err
+		},
+	}
+	for name, collect := range collectors {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		err := collect(ctx)
+		cancel()
+		if !errors.Is(err, sdkerrors.ErrContextDeadline) {
+			t.Errorf("%s.CollectAll() error = %v, want ErrContextDeadline", name, err)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// AddressLabeler resolves human-readable labels for addresses, such as
+// exchange or contract names. When set with WithAddressLabeler, it is
+// consulted to fill the label fields of asset transfers and address
+// activity. Labels are best-effort: an error or empty label leaves the
+// address unlabeled.
+type AddressLabeler interface {
+	// LabelAddress returns the label for address, or "" if it has none.
+	LabelAddress(ctx context.Context, address types.Address) (string, error)
+}
+
+// AddressLabelerFunc adapts a function to an AddressLabeler.
+type AddressLabelerFunc func(ctx context.Context, address types.Address) (string, error)
+
+// LabelAddress implements AddressLabeler.
+func (f AddressLabelerFunc) LabelAddress(ctx context.Context, address types.Address) (string, error) {
+	return f(ctx, address)
+}
+
+// WithAddressLabeler sets the labeler used to annotate transfer and activity
+// results. Passing nil disables labeling (the default). It must be called
+// before the client is used concurrently.
+func (c *Client) WithAddressLabeler(labeler AddressLabeler) *Client {
+	c.labeler = labeler
+	return c
+}
+
+// labelAddresses resolves labels for the unique, non-empty addresses in
+// addrs using the configured labeler. Keys are lowercase addresses.
+func (c *Client) labelAddresses(ctx context.Context, addrs []string) map[string]string {
+	labels := make(map[string]string)
+	seen := make(map[string]struct{}, len(addrs))
+	for _, a := range addrs {
+		key := strings.ToLower(a)
+		if key == "" {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		label, err := c.labeler.LabelAddress(ctx, types.Address(a))
+		if err == nil && label != "" {
+			labels[key] = label
+		}
+	}
+	return labels
+}
+
+// LabelTransfers fills FromLabel and ToLabel of transfers using the
+// configured labeler. It is a no-op if no labeler is set. GetAssetTransfers
+// calls it automatically.
+func (c *Client) LabelTransfers(ctx context.Context, transfers []AssetTransfer) {
+	if c.labeler == nil || len(transfers) == 0 {
+		return
+	}
+
+	addrs := make([]string, 0, 2*len(transfers))
+	for _, t := range transfers {
+		addrs = append(addrs, t.From.String())
+		if t.To != nil {
+			addrs = append(addrs, t.To.String())
+		}
+	}
+	labels := c.labelAddresses(ctx, addrs)
+
+	for i := range transfers {
+		t := &transfers[i]
+		t.FromLabel = labels[strings.ToLower(t.From.String())]
+		if t.To != nil {
+			t.ToLabel = labels[strings.ToLower(t.To.String())]
+		}
+	}
+}
+
+// LabelAddressActivity fills FromLabel and ToLabel of an address activity
+// webhook event using the configured labeler. It is a no-op if no labeler
+// is set.
+func (c *Client) LabelAddressActivity(ctx context.Context, event *AddressActivityEvent) {
+	if c.labeler == nil || event == nil || len(event.Activity) == 0 {
+		return
+	}
+
+	addrs := make([]string, 0, 2*len(event.Activity))
+	for _, a := range event.Activity {
+		addrs = append(addrs, a.FromAddress, a.ToAddress)
+	}
+	labels := c.labelAddresses(ctx, addrs)
+
+	for i := range event.Activity {
+		a := &event.Activity[i]
+		a.FromLabel = labels[strings.ToLower(a.FromAddress)]
+		a.ToLabel = labels[strings.ToLower(a.ToAddress)]
+	}
+}
+
+// MetadataLabeler is an AddressLabeler that labels contracts with their
+// token or NFT collection name, using GetTokenMetadata and
+// GetContractMetadata. Externally owned accounts are detected with
+// eth_getCode and left unlabeled. Results, including misses, are cached for
+// the lifetime of the labeler.
+type MetadataLabeler struct {
+	client *Client
+
+	mu     sync.Mutex
+	labels map[string]string
+}
+
+// NewMetadataLabeler creates a MetadataLabeler backed by client.
+func NewMetadataLabeler(client *Client) *MetadataLabeler {
+	return &MetadataLabeler{
+		client: client,
+		labels: make(map[string]string),
+	}
+}
+
+// LabelAddress implements AddressLabeler.
+func (l *MetadataLabeler) LabelAddress(ctx context.Context, address types.Address) (string, error) {
+	key := strings.ToLower(address.String())
+
+	l.mu.Lock()
+	label, ok := l.labels[key]
+	l.mu.Unlock()
+	if ok {
+		return label, nil
+	}
+
+	label, err := l.resolve(ctx, address)
+	if err != nil {
+		return "", err
+	}
+
+	l.mu.Lock()
+	l.labels[key] = label
+	l.mu.Unlock()
+	return label, nil
+}
+
+// resolve looks up the contract name of address.
+func (l *MetadataLabeler) resolve(ctx context.Context, address types.Address) (string, error) {
+	var code types.Data
+	if err := l.client.rpc.Call(ctx, "eth_getCode", []interface{}{address.String(), "latest"}, &code); err != nil {
+		return "", err
+	}
+	if len(code.Bytes()) == 0 {
+		return "", nil
+	}
+
+	if token, err := l.client.GetTokenMetadata(ctx, address); err == nil && token.Name != nil && *token.Name != "" {
+		return *token.Name, nil
+	}
+	contract, err := l.client.GetContractMetadata(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	if contract.Name != nil {
+		return *contract.Name, nil
+	}
+	return "", nil
+}
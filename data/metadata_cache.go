@@ -0,0 +1,243 @@
+package data
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// TokenMetadataCacheStats reports token metadata cache usage.
+type TokenMetadataCacheStats struct {
+	// Hits is the number of lookups served from the cache.
+	Hits uint64
+	// Misses is the number of lookups that required an API call.
+	Misses uint64
+	// Entries is the number of cached tokens.
+	Entries int
+}
+
+// tokenMetadataCache is an LRU cache of token metadata with a TTL.
+// Concurrent misses for the same token share a single API call.
+type tokenMetadataCache struct {
+	mu       sync.Mutex
+	size     int
+	ttl      time.Duration
+	entries  map[string]*list.Element
+	lru      *list.List
+	inflight map[string]*metadataCall
+	hits     uint64
+	misses   uint64
+}
+
+// metadataEntry is a cached value.
+type metadataEntry struct {
+	key       string
+	metadata  TokenMetadata
+	expiresAt time.Time
+}
+
+// metadataCall is an in-flight fetch shared by concurrent callers.
+type metadataCall struct {
+	done     chan struct{}
+	cancel   context.CancelFunc
+	waiters  int
+	metadata *TokenMetadata
+	err      error
+}
+
+func newTokenMetadataCache(size int, ttl time.Duration) *tokenMetadataCache {
+	return &tokenMetadataCache{
+		size:     size,
+		ttl:      ttl,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+		inflight: make(map[string]*metadataCall),
+	}
+}
+
+// get returns the cached metadata for key, calling fetch on a miss.
+// The shared fetch runs on a context detached from the callers' contexts
+// and is cancelled only when every caller has stopped waiting. Each caller
+// receives its own copy of the metadata.
+func (c *tokenMetadataCache) get(ctx context.Context, key string, fetch func(context.Context) (*TokenMetadata, error)) (*TokenMetadata, error) {
+	c.mu.Lock()
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*metadataEntry)
+		if c.ttl <= 0 || time.Now().Before(entry.expiresAt) {
+			c.lru.MoveToFront(el)
+			c.hits++
+			metadata := cloneTokenMetadata(&entry.metadata)
+			c.mu.Unlock()
+			return metadata, nil
+		}
+		c.removeElement(el)
+	}
+
+	c.misses++
+	call, ok := c.inflight[key]
+	if !ok {
+		fetchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &metadataCall{done: make(chan struct{}), cancel: cancel}
+		c.inflight[key] = call
+		go c.fetch(fetchCtx, key, call, fetch)
+	}
+	call.waiters++
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		c.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			c.forget(key, call)
+			call.cancel()
+		}
+		c.mu.Unlock()
+		return nil, ctx.Err()
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	return cloneTokenMetadata(call.metadata), nil
+}
+
+// fetch runs a shared fetch and publishes its result to the waiters. A panic
+// in fetchFn is reported to the waiters as an error.
+func (c *tokenMetadataCache) fetch(ctx context.Context, key string, call *metadataCall, fetchFn func(context.Context) (*TokenMetadata, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			call.metadata, call.err = nil, fmt.Errorf("data: token metadata fetch panicked: %v", r)
+		}
+		if call.err == nil && call.metadata == nil {
+			call.metadata = &TokenMetadata{}
+		}
+
+		c.mu.Lock()
+		c.forget(key, call)
+		if call.err == nil {
+			c.store(key, *cloneTokenMetadata(call.metadata))
+		}
+		c.mu.Unlock()
+		call.cancel()
+		close(call.done)
+	}()
+
+	call.metadata, call.err = fetchFn(ctx)
+}
+
+// forget removes call from the in-flight fetches if it is still registered
+// under key. The caller must hold c.mu.
+func (c *tokenMetadataCache) forget(key string, call *metadataCall) {
+	if c.inflight[key] == call {
+		delete(c.inflight, key)
+	}
+}
+
+// cloneTokenMetadata returns a deep copy of m, so that cached values never
+// share pointers with values handed to callers.
+func cloneTokenMetadata(m *TokenMetadata) *TokenMetadata {
+	return &TokenMetadata{
+		Name:     clonePtr(m.Name),
+		Symbol:   clonePtr(m.Symbol),
+		Decimals: clonePtr(m.Decimals),
+		Logo:     clonePtr(m.Logo),
+	}
+}
+
+// clonePtr returns a pointer to a copy of *p, or nil if p is nil.
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// store adds or replaces an entry, evicting the least recently used entry
+// when the cache is full. The caller must hold c.mu.
+func (c *tokenMetadataCache) store(key string, metadata TokenMetadata) {
+	entry := &metadataEntry{key: key, metadata: metadata, expiresAt: time.Now().Add(c.ttl)}
+	if el, ok := c.entries[key]; ok {
+		el.Value = entry
+		c.lru.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.size > 0 && c.lru.Len() > c.size {
+		c.removeElement(c.lru.Back())
+	}
+}
+
+// removeElement removes an entry. The caller must hold c.mu.
+func (c *tokenMetadataCache) removeElement(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*metadataEntry).key)
+}
+
+func (c *tokenMetadataCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.removeElement(el)
+	}
+}
+
+func (c *tokenMetadataCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+func (c *tokenMetadataCache) stats() TokenMetadataCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return TokenMetadataCacheStats{Hits: c.hits, Misses: c.misses, Entries: c.lru.Len()}
+}
+
+// WithTokenMetadataCache enables an in-memory cache for GetTokenMetadata,
+// which the wallet helpers also use. At most size tokens are kept (zero
+// means unbounded) and entries expire after ttl (zero means never).
+// Concurrent lookups of the same uncached token share one API call.
+// The cache is disabled by default. Enable it before the client is used
+// concurrently.
+func (c *Client) WithTokenMetadataCache(size int, ttl time.Duration) *Client {
+	c.metadataCache = newTokenMetadataCache(size, ttl)
+	return c
+}
+
+// CacheStats returns token metadata cache statistics.
+// It returns zero values if the cache is disabled.
+func (c *Client) CacheStats() TokenMetadataCacheStats {
+	if c.metadataCache == nil {
+		return TokenMetadataCacheStats{}
+	}
+	return c.metadataCache.stats()
+}
+
+// InvalidateTokenMetadata removes a token from the metadata cache.
+func (c *Client) InvalidateTokenMetadata(contractAddress types.Address) {
+	if c.metadataCache != nil {
+		c.metadataCache.invalidate(c.tokenMetadataKey(contractAddress))
+	}
+}
+
+// ClearTokenMetadataCache removes all entries from the metadata cache.
+func (c *Client) ClearTokenMetadataCache() {
+	if c.metadataCache != nil {
+		c.metadataCache.clear()
+	}
+}
+
+// tokenMetadataKey returns the cache key for a token. The NFT base URL
+// identifies the network, so a cache is never shared across networks.
+func (c *Client) tokenMetadataKey(contractAddress types.Address) string {
+	return c.nftURL + "|" + strings.ToLower(contractAddress.String())
+}
@@ -0,0 +1,121 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenMetadataCacheFirstCallerCancel(t *testing.T) {
+	cache := newTokenMetadataCache(10, 0)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (*TokenMetadata, error) {
+		close(started)
+		select {
+		case <-release:
+			name := "USD Coin"
+			return &TokenMetadata{Name: &name}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := cache.get(firstCtx, "usdc", fetch)
+		firstErr <- err
+	}()
+	<-started
+
+	second := make(chan *TokenMetadata, 1)
+	go func() {
+		metadata, err := cache.get(context.Background(), "usdc", fetch)
+		if err != nil {
+			t.Errorf("second caller error = %v", err)
+		}
+		second <- metadata
+	}()
+	waitForMetadataWaiters(t, cache, "usdc", 2)
+
+	cancelFirst()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("first caller error = %v, want context.Canceled", err)
+	}
+
+	close(release)
+	if metadata := <-second; metadata == nil || metadata.Name == nil || *metadata.Name != "USD Coin" {
+		t.Errorf("second caller metadata = %+v, want USD Coin", metadata)
+	}
+}
+
+func TestTokenMetadataCacheFetchPanic(t *testing.T) {
+	cache := newTokenMetadataCache(10, 0)
+
+	_, err := cache.get(context.Background(), "bad", func(context.Context) (*TokenMetadata, error) {
+		panic("boom")
+	})
+	if err == nil {
+		t.Fatal("get() error = nil, want panic error")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := cache.get(context.Background(), "bad", func(context.Context) (*TokenMetadata, error) {
+			return &TokenMetadata{}, nil
+		})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("get() after panic error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("get() after panic blocked on the failed fetch")
+	}
+}
+
+func TestTokenMetadataCacheCopies(t *testing.T) {
+	cache := newTokenMetadataCache(10, 0)
+	fetch := func(context.Context) (*TokenMetadata, error) {
+		symbol := "USDC"
+		return &TokenMetadata{Symbol: &symbol}, nil
+	}
+
+	first, err := cache.get(context.Background(), "usdc", fetch)
+	if err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	*first.Symbol = "MUTATED"
+
+	second, err := cache.get(context.Background(), "usdc", fetch)
+	if err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	if *second.Symbol != "USDC" {
+		t.Errorf("cached Symbol = %q, want %q", *second.Symbol, "USDC")
+	}
+}
+
+// waitForMetadataWaiters waits until the in-flight fetch for key has n waiters.
+func waitForMetadataWaiters(t *testing.T, cache *tokenMetadataCache, key string, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		cache.mu.Lock()
+		call, ok := cache.inflight[key]
+		waiters := 0
+		if ok {
+			waiters = call.waiters
+		}
+		cache.mu.Unlock()
+		if waiters == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d waiters", n)
+}
@@ -0,0 +1,662 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// NFTTransferType selects which side of a transfer the owner is on.
+type NFTTransferType string
+
+// NFT transfer types.
+const (
+	NFTTransferSent     NFTTransferType = "sent"
+	NFTTransferReceived NFTTransferType = "received"
+	NFTTransferBoth     NFTTransferType = "both"
+)
+
+// NFTTransfer is a single NFT transfer, as returned by the v3 NFT transfer
+// endpoints. ERC1155 batch transfers have one NFTTransfer per token ID.
+type NFTTransfer struct {
+	// ContractAddress is the NFT contract address.
+	ContractAddress types.Address `json:"contractAddress"`
+	// TokenID is the token ID (hex).
+	TokenID string `json:"tokenId"`
+	// TokenType is the token type (ERC721 or ERC1155).
+	TokenType NFTTokenType `json:"tokenType"`
+	// From is the sender address (zero address for mints).
+	From types.Address `json:"from"`
+	// To is the recipient address (zero address for burns).
+	To types.Address `json:"to"`
+	// Quantity is the number of tokens transferred (hex, always 0x1 for ERC721).
+	Quantity string `json:"quantity"`
+	// TransactionHash is the transaction hash.
+	TransactionHash types.Hash `json:"transactionHash"`
+	// BlockNumber is the block number.
+	BlockNumber uint64 `json:"blockNumber"`
+	// BlockTimestamp is the block timestamp in ISO format.
+	BlockTimestamp string `json:"blockTimestamp,omitempty"`
+	// NFT contains the token metadata (when metadata was requested).
+	NFT *OwnedNFT `json:"nft,omitempty"`
+}
+
+// TokenIDValue returns the parsed token ID.
+func (t *NFTTransfer) TokenIDValue() (TokenID, bool) {
+	return optionalTokenID(&t.TokenID)
+}
+
+// IsMint returns true if the transfer is a mint (sent from the zero address).
+func (t *NFTTransfer) IsMint() bool {
+	return t.From.IsZero()
+}
+
+// IsBurn returns true if the transfer is a burn (sent to the zero address).
+func (t *NFTTransfer) IsBurn() bool {
+	return t.To.IsZero()
+}
+
+// NFTTransfersResponse represents a page of NFT transfers.
+type NFTTransfersResponse struct {
+	// Transfers is the list of transfers.
+	Transfers []NFTTransfer `json:"transfers"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+}
+
+// HasMore returns true if there are more results available.
+func (r *NFTTransfersResponse) HasMore() bool {
+	return r.PageKey != ""
+}
+
+// NFTTransfersParams represents the parameters for GetNFTTransfersForOwner.
+type NFTTransfersParams struct {
+	// Owner is the address whose transfers are returned.
+	Owner types.Address `json:"owner"`
+	// TransferType selects sent, received, or both (default: both).
+	TransferType NFTTransferType `json:"transferType,omitempty"`
+	// ContractAddresses filters transfers by contract addresses.
+	ContractAddresses []types.Address `json:"contractAddresses,omitempty"`
+	// TokenType filters transfers by token type (default: all).
+	TokenType NFTTokenType `json:"tokenType,omitempty"`
+	// WithMetadata attaches token metadata to each transfer.
+	WithMetadata bool `json:"withMetadata,omitempty"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+	// PageSize is the number of transfers per page (max 1000).
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// NewNFTTransfersParams creates new NFTTransfersParams.
+func NewNFTTransfersParams(owner types.Address) *NFTTransfersParams {
+	return &NFTTransfersParams{
+		Owner:        owner,
+		TransferType: NFTTransferBoth,
+	}
+}
+
+// SetTransferType sets which side of the transfer the owner is on.
+func (p *NFTTransfersParams) SetTransferType(transferType NFTTransferType) *NFTTransfersParams {
+	p.TransferType = transferType
+	return p
+}
+
+// SetContractAddresses sets the contract address filter.
+func (p *NFTTransfersParams) SetContractAddresses(addresses []types.Address) *NFTTransfersParams {
+	p.ContractAddresses = addresses
+	return p
+}
+
+// SetTokenType sets the token type filter.
+func (p *NFTTransfersParams) SetTokenType(tokenType NFTTokenType) *NFTTransfersParams {
+	p.TokenType = tokenType
+	return p
+}
+
+// SetWithMetadata enables token metadata on each transfer.
+func (p *NFTTransfersParams) SetWithMetadata(withMetadata bool) *NFTTransfersParams {
+	p.WithMetadata = withMetadata
+	return p
+}
+
+// SetPageKey sets the pagination key.
+func (p *NFTTransfersParams) SetPageKey(pageKey string) *NFTTransfersParams {
+	p.PageKey = pageKey
+	return p
+}
+
+// SetPageSize sets the page size.
+func (p *NFTTransfersParams) SetPageSize(size int) *NFTTransfersParams {
+	p.PageSize = &size
+	return p
+}
+
+// MintedNFTsOptions represents the options for GetMintedNFTs.
+type MintedNFTsOptions struct {
+	// ContractAddresses filters mints by contract addresses.
+	ContractAddresses []types.Address `json:"contractAddresses,omitempty"`
+	// TokenType filters mints by token type (default: all).
+	TokenType NFTTokenType `json:"tokenType,omitempty"`
+	// WithMetadata attaches token metadata to each transfer.
+	WithMetadata bool `json:"withMetadata,omitempty"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+	// PageSize is the number of transfers per page (max 1000).
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// GetNFTTransfersForOwner retrieves the NFT transfers sent and/or received by an
+// owner using getTransfersForOwner. NFTTransferBoth omits the direction filter.
+func (c *Client) GetNFTTransfersForOwner(ctx context.Context, params *NFTTransfersParams) (*NFTTransfersResponse, error) {
+	query := nftTransfersQuery(params.TokenType, params.WithMetadata, params.PageKey, params.PageSize)
+	query.Set("owner", params.Owner.String())
+
+	switch params.TransferType {
+	case NFTTransferSent:
+		query.Set("transferType", "FROM")
+	case NFTTransferReceived:
+		query.Set("transferType", "TO")
+	case NFTTransferBoth, "":
+	default:
+		return nil, fmt.Errorf("%w: NFT transfer type %q", sdkerrors.ErrInvalidParameter, params.TransferType)
+	}
+
+	for _, addr := range params.ContractAddresses {
+		query.Add("contractAddresses[]", addr.String())
+	}
+
+	return c.nftTransfersGet(ctx, "getTransfersForOwner", query, params.WithMetadata)
+}
+
+// GetNFTTransfersForOwnerIterator returns an iterator for paginating through an owner's NFT transfers.
+func (c *Client) GetNFTTransfersForOwnerIterator(ctx context.Context, params *NFTTransfersParams) *NFTTransfersIterator {
+	paramsCopy := *params
+	return newNFTTransfersIterator(ctx, paramsCopy.PageKey, func(ctx context.Context, pageKey string) (*NFTTransfersResponse, error) {
+		paramsCopy.PageKey = pageKey
+		return c.GetNFTTransfersForOwner(ctx, &paramsCopy)
+	})
+}
+
+// GetMintedNFTs retrieves the NFTs minted to an owner using getMintedNfts.
+func (c *Client) GetMintedNFTs(ctx context.Context, owner types.Address, opts *MintedNFTsOptions) (*NFTTransfersResponse, error) {
+	if opts == nil {
+		opts = &MintedNFTsOptions{}
+	}
+
+	query := nftTransfersQuery(opts.TokenType, opts.WithMetadata, opts.PageKey, opts.PageSize)
+	query.Set("owner", owner.String())
+	for _, addr := range opts.ContractAddresses {
+		query.Add("contractAddresses[]", addr.String())
+	}
+
+	return c.nftTransfersGet(ctx, "getMintedNfts", query, opts.WithMetadata)
+}
+
+// GetMintedNFTsIterator returns an iterator for paginating through the NFTs minted to an owner.
+func (c *Client) GetMintedNFTsIterator(ctx context.Context, owner types.Address, opts *MintedNFTsOptions) *NFTTransfersIterator {
+	var optsCopy MintedNFTsOptions
+	if opts != nil {
+		optsCopy = *opts
+	}
+	return newNFTTransfersIterator(ctx, optsCopy.PageKey, func(ctx context.Context, pageKey string) (*NFTTransfersResponse, error) {
+		optsCopy.PageKey = pageKey
+		return c.GetMintedNFTs(ctx, owner, &optsCopy)
+	})
+}
+
+// nftTransferJSON is a transfer row of the v3 NFT transfer endpoints: the
+// token fields of OwnedNFT plus the transfer itself.
+type nftTransferJSON struct {
+	OwnedNFT
+	From            types.Address  `json:"from"`
+	To              types.Address  `json:"to"`
+	TransactionHash types.Hash     `json:"transactionHash"`
+	BlockNumber     types.Quantity `json:"blockNumber"`
+	BlockTimestamp  string         `json:"blockTimestamp,omitempty"`
+	Quantity        string         `json:"quantity,omitempty"`
+}
+
+// nftTransfersQuery builds the query parameters shared by the v3 NFT transfer endpoints.
+func nftTransfersQuery(tokenType NFTTokenType, withMetadata bool, pageKey string, pageSize *int) url.Values {
+	query := url.Values{}
+	query.Set("withMetadata", fmt.Sprintf("%t", withMetadata))
+	if tokenType != "" {
+		query.Set("tokenType", string(tokenType))
+	}
+	if pageKey != "" {
+		query.Set("pageKey", pageKey)
+	}
+	if pageSize != nil {
+		query.Set("pageSize", fmt.Sprintf("%d", *pageSize))
+	}
+	return query
+}
+
+// nftTransfersGet fetches one page from a v3 NFT transfer endpoint. The page
+// key is returned exactly as the API sent it.
+func (c *Client) nftTransfersGet(ctx context.Context, method string, query url.Values, withMetadata bool) (*NFTTransfersResponse, error) {
+	var resp struct {
+		NFTs    []nftTransferJSON `json:"nfts"`
+		PageKey string            `json:"pageKey,omitempty"`
+	}
+	if err := c.nftGet(ctx, method, query, &resp); err != nil {
+		return nil, err
+	}
+
+	result := &NFTTransfersResponse{
+		Transfers: make([]NFTTransfer, 0, len(resp.NFTs)),
+		PageKey:   resp.PageKey,
+	}
+	for i := range resp.NFTs {
+		row := &resp.NFTs[i]
+		transfer := NFTTransfer{
+			ContractAddress: row.Contract.Address,
+			TokenID:         row.TokenID,
+			TokenType:       NFTTokenType(row.TokenType),
+			From:            row.From,
+			To:              row.To,
+			Quantity:        row.Quantity,
+			TransactionHash: row.TransactionHash,
+			BlockNumber:     row.BlockNumber.Uint64(),
+			BlockTimestamp:  row.BlockTimestamp,
+		}
+		if transfer.Quantity == "" && transfer.TokenType == NFTTokenTypeERC721 {
+			transfer.Quantity = hex.EncodeUint64(1)
+		}
+		if withMetadata {
+			nft := row.OwnedNFT
+			transfer.NFT = &nft
+		}
+		result.Transfers = append(result.Transfers, transfer)
+	}
+	return result, nil
+}
+
+// NFTTransfersIterator iterates through NFT transfers with pagination.
+type NFTTransfersIterator struct {
+	fetch   func(ctx context.Context, pageKey string) (*NFTTransfersResponse, error)
+	ctx     context.Context
+	start   string
+	pageKey string
+	current *NFTTransfersResponse
+	index   int
+	done    bool
+	err     error
+	mu      sync.Mutex
+}
+
+// newNFTTransfersIterator creates an iterator that fetches pages with fetch.
+func newNFTTransfersIterator(ctx context.Context, pageKey string, fetch func(ctx context.Context, pageKey string) (*NFTTransfersResponse, error)) *NFTTransfersIterator {
+	return &NFTTransfersIterator{
+		fetch:   fetch,
+		ctx:     ctx,
+		start:   pageKey,
+		pageKey: pageKey,
+	}
+}
+
+// Next returns the next transfer in the iteration.
+// Returns nil when there are no more transfers.
+func (it *NFTTransfersIterator) Next() (*NFTTransfer, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	for {
+		if it.done {
+			return nil, nil
+		}
+
+		if it.current != nil && it.index < len(it.current.Transfers) {
+			transfer := &it.current.Transfers[it.index]
+			it.index++
+			return transfer, nil
+		}
+
+		if it.current != nil && !it.current.HasMore() {
+			it.done = true
+			return nil, nil
+		}
+
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return nil, err
+		}
+	}
+}
+
+// HasNext returns true if there are more transfers to iterate.
+func (it *NFTTransfersIterator) HasNext() bool {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.current != nil && it.index < len(it.current.Transfers) {
+		return true
+	}
+
+	if it.current != nil {
+		return it.current.HasMore()
+	}
+
+	return true
+}
+
+// Error returns any error encountered during iteration.
+func (it *NFTTransfersIterator) Error() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// Reset resets the iterator to the beginning.
+func (it *NFTTransfersIterator) Reset() {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	it.current = nil
+	it.index = 0
+	it.done = false
+	it.err = nil
+	it.pageKey = it.start
+}
+
+// Collect returns all remaining transfers as a slice.
+func (it *NFTTransfersIterator) Collect() ([]NFTTransfer, error) {
+	return it.CollectN(-1)
+}
+
+// CollectN returns up to n transfers. If n <= 0, all remaining transfers are returned.
+func (it *NFTTransfersIterator) CollectN(n int) ([]NFTTransfer, error) {
+	var transfers []NFTTransfer
+
+	for n <= 0 || len(transfers) < n {
+		transfer, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if transfer == nil {
+			break
+		}
+		transfers = append(transfers, *transfer)
+	}
+
+	return transfers, nil
+}
+
+func (it *NFTTransfersIterator) fetchNext() error {
+	result, err := it.fetch(it.ctx, it.pageKey)
+	if err != nil {
+		return err
+	}
+	it.current = result
+	it.index = 0
+	it.pageKey = result.PageKey
+	return nil
+}
+
+// NFTContractTransfersParams represents the parameters for GetNFTTransfersForContract.
+type NFTContractTransfersParams struct {
+	// FromBlock is the starting block (hex or "latest", default: "0x0").
+	FromBlock string `json:"fromBlock,omitempty"`
+	// ToBlock is the ending block (hex or "latest", default: "latest").
+	ToBlock string `json:"toBlock,omitempty"`
+	// Order is the sort order (default: asc).
+	Order SortOrder `json:"order,omitempty"`
+	// TokenType filters transfers by token type (default: all).
+	TokenType NFTTokenType `json:"tokenType,omitempty"`
+	// WithMetadata attaches token metadata to each transfer.
+	WithMetadata bool `json:"withMetadata,omitempty"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+	// PageSize is the number of transfers per page (max 1000).
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// NewNFTContractTransfersParams creates new NFTContractTransfersParams.
+func NewNFTContractTransfersParams() *NFTContractTransfersParams {
+	return &NFTContractTransfersParams{}
+}
+
+// SetBlockRange sets the starting and ending block numbers.
+func (p *NFTContractTransfersParams) SetBlockRange(from, to uint64) *NFTContractTransfersParams {
+	p.FromBlock = hex.EncodeUint64(from)
+	p.ToBlock = hex.EncodeUint64(to)
+	return p
+}
+
+// SetFromBlock sets the starting block.
+func (p *NFTContractTransfersParams) SetFromBlock(block string) *NFTContractTransfersParams {
+	p.FromBlock = block
+	return p
+}
+
+// SetToBlock sets the ending block.
+func (p *NFTContractTransfersParams) SetToBlock(block string) *NFTContractTransfersParams {
+	p.ToBlock = block
+	return p
+}
+
+// SetOrder sets the sort order.
+func (p *NFTContractTransfersParams) SetOrder(order SortOrder) *NFTContractTransfersParams {
+	p.Order = order
+	return p
+}
+
+// SetTokenType sets the token type filter.
+func (p *NFTContractTransfersParams) SetTokenType(tokenType NFTTokenType) *NFTContractTransfersParams {
+	p.TokenType = tokenType
+	return p
+}
+
+// SetWithMetadata enables token metadata on each transfer.
+func (p *NFTContractTransfersParams) SetWithMetadata(withMetadata bool) *NFTContractTransfersParams {
+	p.WithMetadata = withMetadata
+	return p
+}
+
+// SetPageKey sets the pagination key.
+func (p *NFTContractTransfersParams) SetPageKey(pageKey string) *NFTContractTransfersParams {
+	p.PageKey = pageKey
+	return p
+}
+
+// SetPageSize sets the page size.
+func (p *NFTContractTransfersParams) SetPageSize(size int) *NFTContractTransfersParams {
+	p.PageSize = &size
+	return p
+}
+
+// GetNFTTransfersForContract retrieves the transfers of a single NFT contract
+// using getTransfersForContract. Rows use the same NFTTransfer type as GetNFTTransfersForOwner.
+func (c *Client) GetNFTTransfersForContract(ctx context.Context, contractAddress types.Address, params *NFTContractTransfersParams) (*NFTTransfersResponse, error) {
+	return c.nftContractTransfers(ctx, contractAddress, params, false)
+}
+
+// GetNFTTransfersForContractIterator returns an iterator for paginating through a contract's NFT transfers.
+func (c *Client) GetNFTTransfersForContractIterator(ctx context.Context, contractAddress types.Address, params *NFTContractTransfersParams) *NFTTransfersIterator {
+	paramsCopy := contractTransfersParamsCopy(params)
+	return newNFTTransfersIterator(ctx, paramsCopy.PageKey, func(ctx context.Context, pageKey string) (*NFTTransfersResponse, error) {
+		paramsCopy.PageKey = pageKey
+		return c.nftContractTransfers(ctx, contractAddress, &paramsCopy, false)
+	})
+}
+
+// GetNFTMintsForContract retrieves only the mints of a contract (transfers from the zero address).
+// The mints are filtered from GetNFTTransfersForContract pages client-side.
+func (c *Client) GetNFTMintsForContract(ctx context.Context, contractAddress types.Address, params *NFTContractTransfersParams) (*NFTTransfersResponse, error) {
+	return c.nftContractTransfers(ctx, contractAddress, params, true)
+}
+
+// GetNFTMintsForContractIterator returns an iterator for paginating through a contract's mints.
+func (c *Client) GetNFTMintsForContractIterator(ctx context.Context, contractAddress types.Address, params *NFTContractTransfersParams) *NFTTransfersIterator {
+	paramsCopy := contractTransfersParamsCopy(params)
+	return newNFTTransfersIterator(ctx, paramsCopy.PageKey, func(ctx context.Context, pageKey string) (*NFTTransfersResponse, error) {
+		paramsCopy.PageKey = pageKey
+		return c.nftContractTransfers(ctx, contractAddress, &paramsCopy, true)
+	})
+}
+
+// nftContractTransfers fetches one page of transfers for a contract, optionally restricted to mints.
+// getTransfersForContract has no sender filter, so mints are selected client-side
+// and a page may hold fewer rows than PageSize, or none, while HasMore is true.
+func (c *Client) nftContractTransfers(ctx context.Context, contractAddress types.Address, params *NFTContractTransfersParams, mintsOnly bool) (*NFTTransfersResponse, error) {
+	if params == nil {
+		params = &NFTContractTransfersParams{}
+	}
+
+	query := nftTransfersQuery(params.TokenType, params.WithMetadata, params.PageKey, params.PageSize)
+	query.Set("contractAddress", contractAddress.String())
+	if params.FromBlock != "" {
+		query.Set("fromBlock", params.FromBlock)
+	}
+	if params.ToBlock != "" {
+		query.Set("toBlock", params.ToBlock)
+	}
+	if params.Order != "" {
+		query.Set("order", string(params.Order))
+	}
+
+	result, err := c.nftTransfersGet(ctx, "getTransfersForContract", query, params.WithMetadata)
+	if err != nil {
+		return nil, err
+	}
+	if mintsOnly {
+		mints := result.Transfers[:0]
+		for _, t := range result.Transfers {
+			if t.IsMint() {
+				mints = append(mints, t)
+			}
+		}
+		result.Transfers = mints
+	}
+	return result, nil
+}
+
+// contractTransfersParamsCopy returns a copy of params, or zero-value params if nil.
+func contractTransfersParamsCopy(params *NFTContractTransfersParams) NFTContractTransfersParams {
+	if params == nil {
+		return NFTContractTransfersParams{}
+	}
+	return *params
+}
+
+// NFTTransferHistoryParams bounds the scan made by GetNFTTransferHistory.
+type NFTTransferHistoryParams struct {
+	// FromBlock is the starting block (hex, default: "0x0").
+	FromBlock string `json:"fromBlock,omitempty"`
+	// ToBlock is the ending block (hex or "latest", default: "latest").
+	ToBlock string `json:"toBlock,omitempty"`
+	// Collect limits the pages scanned and the transfers returned.
+	Collect *CollectOptions `json:"-"`
+}
+
+// NewNFTTransferHistoryParams creates new NFTTransferHistoryParams.
+func NewNFTTransferHistoryParams() *NFTTransferHistoryParams {
+	return &NFTTransferHistoryParams{}
+}
+
+// SetBlockRange sets the starting and ending block numbers.
+func (p *NFTTransferHistoryParams) SetBlockRange(from, to uint64) *NFTTransferHistoryParams {
+	p.FromBlock = hex.EncodeUint64(from)
+	p.ToBlock = hex.EncodeUint64(to)
+	return p
+}
+
+// SetCollectOptions sets the page and item limits of the scan.
+func (p *NFTTransferHistoryParams) SetCollectOptions(opts *CollectOptions) *NFTTransferHistoryParams {
+	p.Collect = opts
+	return p
+}
+
+// GetNFTTransferHistory retrieves the transfers of a single token, in
+// ascending block order: the token's chain of custody. alchemy_getAssetTransfers
+// cannot filter by token ID, so the contract's ERC721 and ERC1155 transfers in
+// the block range are paged through and filtered client-side; ERC1155 batch
+// transfers are trimmed to the matching token.
+//
+// Each page costs 150 CU and holds up to 1000 transfers of the whole contract,
+// so an unbounded scan of a large collection can take thousands of calls.
+// Narrow the block range and set Collect.MaxPages to bound the cost. MaxItems
+// counts matching transfers, and OnPage reports each scanned page with the
+// number of matches so far. The scan stops when the block range is exhausted
+// or a limit is reached; the returned flag is true if a limit stopped it early.
+// On error, the transfers found so far are returned with it.
+func (c *Client) GetNFTTransferHistory(ctx context.Context, contractAddress types.Address, tokenID string, params *NFTTransferHistoryParams) ([]AssetTransfer, bool, error) {
+	want, err := ParseTokenID(tokenID)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: token ID %q", sdkerrors.ErrInvalidParameter, tokenID)
+	}
+	if params == nil {
+		params = &NFTTransferHistoryParams{}
+	}
+	o := params.Collect.withDefaults()
+
+	query := &AssetTransfersParams{
+		FromBlock:         params.FromBlock,
+		ToBlock:           params.ToBlock,
+		ContractAddresses: []types.Address{contractAddress},
+		Category:          []AssetTransferCategory{CategoryERC721, CategoryERC1155},
+		Order:             SortAsc,
+		WithMetadata:      true,
+	}
+
+	history := []AssetTransfer{}
+	for page := 0; ; page++ {
+		if o.MaxPages > 0 && page >= o.MaxPages {
+			return history, true, nil
+		}
+
+		resp, err := c.GetAssetTransfers(ctx, query)
+		if err != nil {
+			return history, false, err
+		}
+
+		for i := range resp.Transfers {
+			t, ok := transferOfToken(&resp.Transfers[i], want)
+			if !ok {
+				continue
+			}
+			if len(history) >= o.MaxItems {
+				return history, true, nil
+			}
+			history = append(history, t)
+		}
+
+		if o.OnPage != nil {
+			o.OnPage(page, len(history))
+		}
+		if !resp.HasMore() {
+			return history, false, nil
+		}
+		query.PageKey = resp.PageKey
+	}
+}
+
+// transferOfToken returns t restricted to the token id, and whether t moved it.
+func transferOfToken(t *AssetTransfer, id TokenID) (AssetTransfer, bool) {
+	if t.Category != CategoryERC1155 {
+		got, ok := t.TokenIDValue()
+		return *t, ok && got.Equal(id)
+	}
+
+	for _, m := range t.ERC1155Metadata {
+		if got, ok := m.TokenIDValue(); ok && got.Equal(id) {
+			match := *t
+			match.ERC1155Metadata = []ERC1155Metadata{m}
+			return match, true
+		}
+	}
+	return AssetTransfer{}, false
+}
@@ -0,0 +1,206 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+const testNFTContract = "0x0000000000000000000000000000000000000001"
+
+// nftTransferRow returns a v3 transfer row for token id of testNFTContract.
+func nftTransferRow(id, from, to string, block int) string {
+	return fmt.Sprintf(`{"contract":{"address":%q},"tokenId":%q,"tokenType":"ERC721","name":"Token %s",`+
+		`"from":%q,"to":%q,"transactionHash":"0x%064x","blockNumber":"0x%x"}`,
+		testNFTContract, id, id, from, to, block, block)
+}
+
+func TestGetNFTTransfersForContract(t *testing.T) {
+	const (
+		zero  = "0x0000000000000000000000000000000000000000"
+		alice = "0x00000000000000000000000000000000000000aa"
+	)
+	var queries []string
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("pageKey") == "" {
+			fmt.Fprintf(w, `{"nfts":[%s,%s],"pageKey":"opaque=key/1"}`,
+				nftTransferRow("1", zero, alice, 10), nftTransferRow("1", alice, zero, 12))
+			return
+		}
+		fmt.Fprintf(w, `{"nfts":[%s]}`, nftTransferRow("2", zero, alice, 20))
+	})
+	c := newTestClient(srv)
+	contract := types.MustParseAddress(testNFTContract)
+	params := NewNFTContractTransfersParams().SetBlockRange(1, 100).SetOrder(SortDesc).SetWithMetadata(true).SetPageSize(2)
+
+	page, err := c.GetNFTTransfersForContract(context.Background(), contract, params)
+	if err != nil {
+		t.Fatalf("GetNFTTransfersForContract() error = %v", err)
+	}
+	if got := srv.Paths()[0]; got != "/nft/v3/test-key/getTransfersForContract" {
+		t.Errorf("path = %s, want getTransfersForContract", got)
+	}
+	want := "contractAddress=" + testNFTContract + "&fromBlock=0x1&order=desc&pageSize=2&toBlock=0x64&withMetadata=true"
+	if queries[0] != want {
+		t.Errorf("query = %s, want %s", queries[0], want)
+	}
+	if len(page.Transfers) != 2 || page.PageKey != "opaque=key/1" {
+		t.Fatalf("got %d transfers, page key %q; want 2, opaque=key/1", len(page.Transfers), page.PageKey)
+	}
+	first := page.Transfers[0]
+	if !first.IsMint() || first.BlockNumber != 10 || first.Quantity != "0x1" || first.NFT == nil || *first.NFT.Name != "Token 1" {
+		t.Errorf("first transfer = %+v, want mint of token 1 at block 10 with metadata", first)
+	}
+	if !page.Transfers[1].IsBurn() {
+		t.Errorf("second transfer = %+v, want burn", page.Transfers[1])
+	}
+
+	mints, err := c.GetNFTMintsForContractIterator(context.Background(), contract, nil).Collect()
+	if err != nil {
+		t.Fatalf("GetNFTMintsForContractIterator().Collect() error = %v", err)
+	}
+	if len(mints) != 2 || mints[0].TokenID != "1" || mints[1].TokenID != "2" || mints[0].NFT != nil {
+		t.Errorf("mints = %+v, want tokens 1 and 2 without metadata", mints)
+	}
+	if got := queries[len(queries)-1]; got != "contractAddress="+testNFTContract+"&pageKey=opaque%3Dkey%2F1&withMetadata=false" {
+		t.Errorf("second page query = %s, want page key passed through", got)
+	}
+}
+
+func TestGetNFTTransfersForOwner(t *testing.T) {
+	const (
+		owner = "0x00000000000000000000000000000000000000aa"
+		other = "0x00000000000000000000000000000000000000bb"
+	)
+	var queries []string
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		switch r.URL.Query().Get("pageKey") {
+		case "":
+			fmt.Fprintf(w, `{"nfts":[%s],"pageKey":"cursor|2"}`, nftTransferRow("1", other, owner, 10))
+		default:
+			fmt.Fprintf(w, `{"nfts":[%s],"pageKey":null}`, nftTransferRow("1", owner, other, 11))
+		}
+	})
+	c := newTestClient(srv)
+	ownerAddr := types.MustParseAddress(owner)
+	contract := types.MustParseAddress(testNFTContract)
+
+	transfers, err := c.GetNFTTransfersForOwnerIterator(context.Background(),
+		NewNFTTransfersParams(ownerAddr).SetContractAddresses([]types.Address{contract})).Collect()
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(transfers) != 2 || transfers[0].BlockNumber != 10 || transfers[1].BlockNumber != 11 {
+		t.Errorf("transfers = %+v, want blocks 10 and 11", transfers)
+	}
+	want := []string{
+		"contractAddresses%5B%5D=" + testNFTContract + "&owner=" + owner + "&withMetadata=false",
+		"contractAddresses%5B%5D=" + testNFTContract + "&owner=" + owner + "&pageKey=cursor%7C2&withMetadata=false",
+	}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("queries = %q, want direction omitted and page key passed through", queries)
+	}
+
+	tests := []struct {
+		transferType NFTTransferType
+		want         string
+	}{
+		{NFTTransferSent, "FROM"},
+		{NFTTransferReceived, "TO"},
+	}
+	for _, tt := range tests {
+		queries = nil
+		params := NewNFTTransfersParams(ownerAddr).SetTransferType(tt.transferType)
+		if _, err := c.GetNFTTransfersForOwner(context.Background(), params); err != nil {
+			t.Fatalf("GetNFTTransfersForOwner(%s) error = %v", tt.transferType, err)
+		}
+		if got := queries[0]; got != "owner="+owner+"&transferType="+tt.want+"&withMetadata=false" {
+			t.Errorf("GetNFTTransfersForOwner(%s) query = %s, want transferType=%s", tt.transferType, got, tt.want)
+		}
+	}
+
+	params := NewNFTTransfersParams(ownerAddr).SetTransferType("sideways")
+	if _, err := c.GetNFTTransfersForOwner(context.Background(), params); !errors.Is(err, sdkerrors.ErrInvalidParameter) {
+		t.Errorf("GetNFTTransfersForOwner(sideways) error = %v, want ErrInvalidParameter", err)
+	}
+
+	queries = nil
+	minted, err := c.GetMintedNFTs(context.Background(), ownerAddr, &MintedNFTsOptions{TokenType: NFTTokenTypeERC721, WithMetadata: true})
+	if err != nil {
+		t.Fatalf("GetMintedNFTs() error = %v", err)
+	}
+	if got := srv.Paths()[len(srv.Paths())-1]; got != "/nft/v3/test-key/getMintedNfts" {
+		t.Errorf("path = %s, want getMintedNfts", got)
+	}
+	if got := queries[0]; got != "owner="+owner+"&tokenType=ERC721&withMetadata=true" {
+		t.Errorf("GetMintedNFTs() query = %s", got)
+	}
+	if len(minted.Transfers) != 1 || minted.Transfers[0].NFT == nil || minted.PageKey != "cursor|2" {
+		t.Errorf("GetMintedNFTs() = %+v, want one transfer with metadata and the API page key", minted)
+	}
+}
+
+func TestGetNFTTransferHistory(t *testing.T) {
+	var params []map[string]interface{}
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req client.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		p, _ := req.Params[0].(map[string]interface{})
+		params = append(params, p)
+
+		page := len(params)
+		result := map[string]interface{}{"transfers": []map[string]interface{}{
+			{"category": "erc721", "tokenId": "0x7", "uniqueId": fmt.Sprintf("%d:a", page), "blockNum": fmt.Sprintf("0x%x", page)},
+			{"category": "erc721", "tokenId": "0x8", "uniqueId": fmt.Sprintf("%d:b", page), "blockNum": fmt.Sprintf("0x%x", page)},
+			{"category": "erc1155", "uniqueId": fmt.Sprintf("%d:c", page), "blockNum": fmt.Sprintf("0x%x", page),
+				"erc1155Metadata": []map[string]string{{"tokenId": "0x8", "value": "0x1"}, {"tokenId": "0x7", "value": "0x2"}}},
+		}}
+		if page < 3 {
+			result["pageKey"] = fmt.Sprintf("page%d", page+1)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	})
+	c := newTestClient(srv)
+	contract := types.MustParseAddress(testNFTContract)
+
+	var pages []int
+	opts := &CollectOptions{MaxPages: 2, OnPage: func(pageIndex, itemsSoFar int) { pages = append(pages, itemsSoFar) }}
+	history, truncated, err := c.GetNFTTransferHistory(context.Background(), contract, "7",
+		NewNFTTransferHistoryParams().SetBlockRange(1, 500).SetCollectOptions(opts))
+	if err != nil {
+		t.Fatalf("GetNFTTransferHistory() error = %v", err)
+	}
+	if !truncated || len(params) != 2 || len(history) != 4 {
+		t.Fatalf("got %d transfers in %d requests, truncated = %v; want 4 in 2, truncated", len(history), len(params), truncated)
+	}
+	if params[0]["fromBlock"] != "0x1" || params[0]["toBlock"] != "0x1f4" || params[1]["pageKey"] != "page2" {
+		t.Errorf("params = %v, want block range and page key", params)
+	}
+	if !reflect.DeepEqual(pages, []int{2, 4}) {
+		t.Errorf("OnPage counts = %v, want [2 4]", pages)
+	}
+	if m := history[1].ERC1155Metadata; len(m) != 1 || m[0].TokenID != "0x7" {
+		t.Errorf("ERC1155 transfer metadata = %+v, want only token 0x7", m)
+	}
+
+	params = nil
+	history, truncated, err = c.GetNFTTransferHistory(context.Background(), contract, "7", nil)
+	if err != nil || truncated || len(params) != 3 || len(history) != 6 {
+		t.Errorf("unbounded scan = %d transfers in %d requests, truncated = %v, error = %v; want 6 in 3",
+			len(history), len(params), truncated, err)
+	}
+
+	if _, _, err := c.GetNFTTransferHistory(context.Background(), contract, "not-a-token", nil); !errors.Is(err, sdkerrors.ErrInvalidParameter) {
+		t.Errorf("invalid token ID error = %v, want ErrInvalidParameter", err)
+	}
+}
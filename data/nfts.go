@@ -2,14 +2,25 @@ package data
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 	"net/url"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
 	"github.com/ABT-Tech-Limited/alchemy-go/types"
 )
 
+// ErrNFTSnapshotMismatch is returned by an NFTsForOwnerIterator with
+// consistent snapshots required when a page is served from a different block
+// than the first page.
+var ErrNFTSnapshotMismatch = errors.New("NFT ownership snapshot changed during pagination")
+
 // GetNFTsForOwner retrieves NFTs owned by an address.
 func (c *Client) GetNFTsForOwner(ctx context.Context, params *NFTsForOwnerParams) (*NFTsForOwnerResponse, error) {
 	query := url.Values{}
@@ -61,6 +72,11 @@ func (c *Client) GetNFTsForOwner(ctx context.Context, params *NFTsForOwnerParams
 }
 
 // GetNFTsForOwnerIterator returns an iterator for paginating through NFTs.
+//
+// getNFTsForOwner cannot be pinned to a block, so each page reflects
+// ownership at the block reported in its ValidAt, and a long pagination may
+// mix ownership states from different blocks. Use
+// SetRequireConsistentSnapshot to detect this.
 func (c *Client) GetNFTsForOwnerIterator(ctx context.Context, params *NFTsForOwnerParams) *NFTsForOwnerIterator {
 	paramsCopy := *params
 	return &NFTsForOwnerIterator{
@@ -76,10 +92,16 @@ type NFTsForOwnerIterator struct {
 	params  *NFTsForOwnerParams
 	ctx     context.Context
 	current *NFTsForOwnerResponse
+	validAt *ValidAt
+	strict  bool
 	index   int
+	pages   int
 	done    bool
 	err     error
 	mu      sync.Mutex
+
+	// collect is set while CollectAll runs.
+	collect *collectState
 }
 
 // Next returns the next NFT in the iteration.
@@ -105,6 +127,7 @@ func (it *NFTsForOwnerIterator) Next() (*OwnedNFT, error) {
 	if it.index < len(it.current.OwnedNFTs) {
 		nft := &it.current.OwnedNFTs[it.index]
 		it.index++
+		it.collect.count()
 		return nft, nil
 	}
 
@@ -113,6 +136,9 @@ func (it *NFTsForOwnerIterator) Next() (*OwnedNFT, error) {
 		return nil, nil
 	}
 
+	if !it.collect.allowFetch(it.pages) {
+		return nil, nil
+	}
 	it.params.PageKey = it.current.PageKey
 	if err := it.fetchNext(); err != nil {
 		it.err = err
@@ -126,6 +152,7 @@ func (it *NFTsForOwnerIterator) Next() (*OwnedNFT, error) {
 
 	nft := &it.current.OwnedNFTs[0]
 	it.index = 1
+	it.collect.count()
 	return nft, nil
 }
 
@@ -156,16 +183,40 @@ func (it *NFTsForOwnerIterator) Error() error {
 	return it.err
 }
 
-// TotalCount returns the total count of NFTs (available after first fetch).
+// TotalCount returns the total count of NFTs.
+// If no page has been fetched yet, the first page is fetched; on error 0 is
+// returned and the error is available through Error.
 func (it *NFTsForOwnerIterator) TotalCount() int {
 	it.mu.Lock()
 	defer it.mu.Unlock()
+
+	if it.current == nil && it.err == nil {
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return 0
+		}
+	}
+
 	if it.current != nil {
 		return it.current.TotalCount
 	}
 	return 0
 }
 
+// Reset resets the iterator to the beginning.
+func (it *NFTsForOwnerIterator) Reset() {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	it.current = nil
+	it.index = 0
+	it.pages = 0
+	it.done = false
+	it.err = nil
+	it.validAt = nil
+	it.params.PageKey = ""
+}
+
 // Collect returns all remaining NFTs as a slice.
 func (it *NFTsForOwnerIterator) Collect() ([]OwnedNFT, error) {
 	var nfts []OwnedNFT
@@ -184,13 +235,108 @@ func (it *NFTsForOwnerIterator) Collect() ([]OwnedNFT, error) {
 	return nfts, nil
 }
 
+// CollectN returns up to n NFTs.
+func (it *NFTsForOwnerIterator) CollectN(n int) ([]OwnedNFT, error) {
+	nfts := make([]OwnedNFT, 0, n)
+
+	for i := 0; i < n; i++ {
+		nft, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if nft == nil {
+			break
+		}
+		nfts = append(nfts, *nft)
+	}
+
+	return nfts, nil
+}
+
+// CollectAll returns the remaining NFTs, stopping at the limits in opts.
+// Page requests made by the call use ctx. The returned flag is true if a
+// limit was reached while more NFTs were available. On error, the NFTs
+// gathered so far are returned with it.
+func (it *NFTsForOwnerIterator) CollectAll(ctx context.Context, opts *CollectOptions) ([]OwnedNFT, bool, error) {
+	o := opts.withDefaults()
+	state := newCollectState(ctx, o)
+	it.mu.Lock()
+	it.collect = state
+	it.mu.Unlock()
+	defer func() {
+		it.mu.Lock()
+		it.collect = nil
+		it.mu.Unlock()
+	}()
+
+	var nfts []OwnedNFT
+	for {
+		if err := ctx.Err(); err != nil {
+			return nfts, false, client.ContextError(ctx, err)
+		}
+
+		if len(nfts) >= o.MaxItems {
+			return nfts, it.HasNext(), nil
+		}
+
+		nft, err := it.Next()
+		if o.OnPage != nil {
+			state.drain(&it.mu, o.OnPage)
+		}
+		if err != nil {
+			return nfts, false, err
+		}
+		if nft == nil {
+			break
+		}
+		nfts = append(nfts, *nft)
+	}
+
+	it.mu.Lock()
+	state.finish(it.pages)
+	limited := state.limited
+	it.mu.Unlock()
+	if o.OnPage != nil {
+		state.drain(&it.mu, o.OnPage)
+	}
+	return nfts, limited && it.HasNext(), nil
+}
+
+// SetRequireConsistentSnapshot makes the iteration fail with
+// ErrNFTSnapshotMismatch when a page reports a different ValidAt block than
+// the first page. Every NFT returned before the error then reflects
+// ownership at the same block.
+func (it *NFTsForOwnerIterator) SetRequireConsistentSnapshot(required bool) *NFTsForOwnerIterator {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	it.strict = required
+	return it
+}
+
+// ValidAt returns the block of the first page (available after first fetch).
+func (it *NFTsForOwnerIterator) ValidAt() *ValidAt {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.validAt
+}
+
 func (it *NFTsForOwnerIterator) fetchNext() error {
-	result, err := it.client.GetNFTsForOwner(it.ctx, it.params)
+	result, err := it.client.GetNFTsForOwner(it.collect.context(it.ctx), it.params)
 	if err != nil {
 		return err
 	}
+	if result.ValidAt != nil {
+		if it.validAt == nil {
+			it.validAt = result.ValidAt
+		} else if it.strict && result.ValidAt.BlockNumber != it.validAt.BlockNumber {
+			return fmt.Errorf("%w: first page at block %d, page %d at block %d",
+				ErrNFTSnapshotMismatch, it.validAt.BlockNumber, it.pages+1, result.ValidAt.BlockNumber)
+		}
+	}
 	it.current = result
 	it.index = 0
+	it.pages++
+	it.collect.fetched(it.pages)
 	return nil
 }
 
@@ -215,149 +361,907 @@ func (c *Client) GetNFTMetadata(ctx context.Context, params *NFTMetadataParams)
 	return &result, nil
 }
 
-// GetContractMetadata retrieves metadata for an NFT contract.
-func (c *Client) GetContractMetadata(ctx context.Context, contractAddress types.Address) (*NFTContractMetadata, error) {
-	query := url.Values{}
-	query.Set("contractAddress", contractAddress.String())
-
-	var result NFTContractMetadata
-	if err := c.nftGet(ctx, "getContractMetadata", query, &result); err != nil {
+// RefreshNFTMetadata asks Alchemy to re-ingest the metadata of a single NFT
+// from its token URI via the refreshNftMetadata endpoint and returns the
+// freshly ingested metadata. Unlike GetNFTMetadata with RefreshCache, the
+// token is always re-pulled from source. If the endpoint only queued the
+// refresh, nil is returned; use RefreshNFTMetadataWithParams with
+// SetCompareWithPrevious to get the timestamp WaitForNFTMetadataUpdate needs.
+func (c *Client) RefreshNFTMetadata(ctx context.Context, contractAddress types.Address, tokenID string) (*OwnedNFT, error) {
+	body := map[string]string{
+		"contractAddress": contractAddress.String(),
+		"tokenId":         tokenID,
+	}
+	var result OwnedNFT
+	if err := c.nftPost(ctx, "refreshNftMetadata", body, &result); err != nil {
 		return nil, err
 	}
+	if result.TokenID == "" {
+		// The endpoint only acknowledged the request.
+		return nil, nil
+	}
 	return &result, nil
 }
 
-// GetNFTsForContract retrieves all NFTs for a contract.
-func (c *Client) GetNFTsForContract(ctx context.Context, contractAddress types.Address, pageKey string, withMetadata bool) (*NFTsForContractResponse, error) {
-	query := url.Values{}
-	query.Set("contractAddress", contractAddress.String())
-	query.Set("withMetadata", fmt.Sprintf("%t", withMetadata))
+// RefreshNFTMetadataWithParams is RefreshNFTMetadata with additional options.
+// With CompareWithPrevious, the current metadata is read before the refresh,
+// which costs an extra getNFTMetadata call; with FallbackToCached, the cached
+// metadata is read only when the refresh was queued.
+func (c *Client) RefreshNFTMetadataWithParams(ctx context.Context, params *RefreshNFTMetadataParams) (*NFTRefreshResult, error) {
+	var before *OwnedNFT
+	result := &NFTRefreshResult{}
+	if params.CompareWithPrevious {
+		var err error
+		before, err = c.GetNFTMetadata(ctx, NewNFTMetadataParams(params.ContractAddress, params.TokenID))
+		if err != nil {
+			return nil, err
+		}
+		result.PreviousTimeLastUpdated = before.TimeLastUpdated
+	}
 
-	if pageKey != "" {
-		query.Set("startToken", pageKey)
+	after, err := c.RefreshNFTMetadata(ctx, params.ContractAddress, params.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	if after != nil {
+		result.NFT = after
+		result.Refreshed = before == nil || !sameTimeLastUpdated(before.TimeLastUpdated, after.TimeLastUpdated)
+		return result, nil
 	}
 
-	var result NFTsForContractResponse
-	if err := c.nftGet(ctx, "getNFTsForContract", query, &result); err != nil {
+	if params.FallbackToCached {
+		if before == nil {
+			before, err = c.GetNFTMetadata(ctx, NewNFTMetadataParams(params.ContractAddress, params.TokenID))
+			if err != nil {
+				return nil, err
+			}
+		}
+		result.NFT = before
+		result.Cached = true
+	}
+	return result, nil
+}
+
+// RefreshContract asks Alchemy to re-ingest the metadata of every token in a
+// contract. The refresh runs asynchronously; the result reports its state.
+func (c *Client) RefreshContract(ctx context.Context, contractAddress types.Address) (*RefreshContractResult, error) {
+	query := url.Values{}
+	query.Set("contractAddress", contractAddress.String())
+
+	var result RefreshContractResult
+	if err := c.nftGet(ctx, "refreshContract", query, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-// NFTsForContractResponse represents the response from getNFTsForContract.
-type NFTsForContractResponse struct {
-	// NFTs is the list of NFTs in the contract.
-	NFTs []OwnedNFT `json:"nfts"`
-	// PageKey is the pagination key.
-	PageKey string `json:"pageKey,omitempty"`
-}
+// WaitForNFTMetadataUpdate polls getNFTMetadata every interval until the NFT's
+// TimeLastUpdated differs from since, or ctx is done.
+// Pass a nil since to wait for the first non-empty TimeLastUpdated.
+func (c *Client) WaitForNFTMetadataUpdate(ctx context.Context, contractAddress types.Address, tokenID string, since *string, interval time.Duration) (*OwnedNFT, error) {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
 
-// HasMore returns true if there are more results available.
-func (r *NFTsForContractResponse) HasMore() bool {
-	return r.PageKey != ""
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		nft, err := c.GetNFTMetadata(ctx, NewNFTMetadataParams(contractAddress, tokenID))
+		if err != nil {
+			return nil, err
+		}
+		if nft.TimeLastUpdated != nil && !sameTimeLastUpdated(since, nft.TimeLastUpdated) {
+			return nft, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, client.ContextError(ctx, ctx.Err())
+		case <-ticker.C:
+		}
+	}
 }
 
-// GetOwnersForNFT retrieves owners for a specific NFT.
-func (c *Client) GetOwnersForNFT(ctx context.Context, contractAddress types.Address, tokenID string) (*OwnersForNFTResponse, error) {
+// InvalidateContractMetadata marks the cached metadata of every token in a
+// contract as stale so it is refetched on the next request.
+// Useful after a collection reveal.
+func (c *Client) InvalidateContractMetadata(ctx context.Context, contractAddress types.Address) (*InvalidateContractResult, error) {
 	query := url.Values{}
 	query.Set("contractAddress", contractAddress.String())
-	query.Set("tokenId", tokenID)
 
-	var result OwnersForNFTResponse
-	if err := c.nftGet(ctx, "getOwnersForNFT", query, &result); err != nil {
+	var result InvalidateContractResult
+	if err := c.nftGet(ctx, "invalidateContract", query, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-// OwnersForNFTResponse represents the response from getOwnersForNFT.
-type OwnersForNFTResponse struct {
-	// Owners is the list of owner addresses.
-	Owners []types.Address `json:"owners"`
-	// PageKey is the pagination key.
-	PageKey string `json:"pageKey,omitempty"`
+// sameTimeLastUpdated reports whether two optional timestamps are equal.
+func sameTimeLastUpdated(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
 }
 
-// GetOwnersForContract retrieves all owners for an NFT contract.
-func (c *Client) GetOwnersForContract(ctx context.Context, contractAddress types.Address, pageKey string, withTokenBalances bool) (*OwnersForContractResponse, error) {
+// GetContractMetadata retrieves metadata for an NFT contract.
+func (c *Client) GetContractMetadata(ctx context.Context, contractAddress types.Address) (*NFTContractMetadata, error) {
 	query := url.Values{}
 	query.Set("contractAddress", contractAddress.String())
-	query.Set("withTokenBalances", fmt.Sprintf("%t", withTokenBalances))
 
-	if pageKey != "" {
-		query.Set("pageKey", pageKey)
-	}
-
-	var result OwnersForContractResponse
-	if err := c.nftGet(ctx, "getOwnersForContract", query, &result); err != nil {
+	var result NFTContractMetadata
+	if err := c.nftGet(ctx, "getContractMetadata", query, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-// OwnersForContractResponse represents the response from getOwnersForContract.
-type OwnersForContractResponse struct {
-	// Owners is the list of owners with their balances.
-	Owners []ContractOwner `json:"ownerAddresses"`
-	// PageKey is the pagination key.
-	PageKey string `json:"pageKey,omitempty"`
+// maxContractMetadataBatchSize is the maximum number of contracts per getContractMetadataBatch call.
+const maxContractMetadataBatchSize = 100
+
+// MissingContractMetadataError is returned by GetContractMetadataBatch when
+// the response omits some of the requested contracts.
+type MissingContractMetadataError struct {
+	// Missing are the contracts absent from the response, in input order.
+	Missing []types.Address
 }
 
-// ContractOwner represents an owner of NFTs in a contract.
-type ContractOwner struct {
-	// OwnerAddress is the owner's address.
-	OwnerAddress types.Address `json:"ownerAddress"`
-	// TokenBalances contains the tokens owned (if withTokenBalances is true).
-	TokenBalances []TokenBalanceEntry `json:"tokenBalances,omitempty"`
+// Error implements the error interface.
+func (e *MissingContractMetadataError) Error() string {
+	return fmt.Sprintf("contract metadata missing for %d contracts", len(e.Missing))
 }
 
-// TokenBalanceEntry represents a token balance entry.
-type TokenBalanceEntry struct {
-	// TokenID is the token ID.
-	TokenID string `json:"tokenId"`
-	// Balance is the balance.
-	Balance string `json:"balance"`
+// Unwrap returns sdkerrors.ErrInvalidResponse.
+func (e *MissingContractMetadataError) Unwrap() error {
+	return sdkerrors.ErrInvalidResponse
 }
 
-// IsSpamContract checks if a contract is classified as spam.
-func (c *Client) IsSpamContract(ctx context.Context, contractAddress types.Address) (bool, error) {
+// GetContractMetadataBatch retrieves metadata for multiple NFT contracts.
+// Requests are split into chunks of 100 addresses and the results are
+// returned in the order of the input addresses, matched case-insensitively.
+// If the response omits contracts, the results still hold one entry per
+// input address, those missing having only Address set, and the error is a
+// *MissingContractMetadataError listing them.
+func (c *Client) GetContractMetadataBatch(ctx context.Context, contractAddresses []types.Address) ([]NFTContractMetadata, error) {
+	byAddress := make(map[string]NFTContractMetadata, len(contractAddresses))
+
+	for start := 0; start < len(contractAddresses); start += maxContractMetadataBatchSize {
+		end := start + maxContractMetadataBatchSize
+		if end > len(contractAddresses) {
+			end = len(contractAddresses)
+		}
+
+		chunk := contractAddresses[start:end]
+		addrs := make([]string, len(chunk))
+		for i, addr := range chunk {
+			addrs[i] = addr.String()
+		}
+
+		var resp struct {
+			Contracts []NFTContractMetadata `json:"contracts"`
+		}
+		body := map[string]interface{}{"contractAddresses": addrs}
+		if err := c.nftPost(ctx, "getContractMetadataBatch", body, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, contract := range resp.Contracts {
+			byAddress[strings.ToLower(contract.Address.String())] = contract
+		}
+	}
+
+	results := make([]NFTContractMetadata, len(contractAddresses))
+	var missing []types.Address
+	for i, addr := range contractAddresses {
+		contract, ok := byAddress[strings.ToLower(addr.String())]
+		if !ok {
+			missing = append(missing, addr)
+			contract = NFTContractMetadata{Address: addr}
+		}
+		results[i] = contract
+	}
+
+	if len(missing) > 0 {
+		return results, &MissingContractMetadataError{Missing: missing}
+	}
+	return results, nil
+}
+
+// GetNFTsForContract retrieves NFTs for a contract.
+func (c *Client) GetNFTsForContract(ctx context.Context, params *NFTsForContractParams) (*NFTsForContractResponse, error) {
 	query := url.Values{}
-	query.Set("contractAddress", contractAddress.String())
+	query.Set("contractAddress", params.ContractAddress.String())
 
-	var result struct {
-		IsSpamContract bool `json:"isSpamContract"`
+	if params.WithMetadata != nil {
+		query.Set("withMetadata", fmt.Sprintf("%t", *params.WithMetadata))
 	}
-	if err := c.nftGet(ctx, "isSpamContract", query, &result); err != nil {
-		return false, err
+
+	if params.PageKey != "" {
+		query.Set("pageKey", params.PageKey)
 	}
-	return result.IsSpamContract, nil
+
+	if params.Limit != nil {
+		query.Set("limit", fmt.Sprintf("%d", *params.Limit))
+	}
+
+	if params.TokenURITimeoutInMs != nil {
+		query.Set("tokenUriTimeoutInMs", fmt.Sprintf("%d", *params.TokenURITimeoutInMs))
+	}
+
+	var result NFTsForContractResponse
+	if err := c.nftGet(ctx, "getNFTsForContract", query, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
 }
 
-// nftGet makes a GET request to the NFT API endpoint.
-func (c *Client) nftGet(ctx context.Context, method string, query url.Values, result interface{}) error {
-	// Build the full URL: nftURL/apiKey/method
-	baseURL := c.nftURL
-	apiKey := c.http.BaseURL()
-
-	// Extract API key from base URL (the part after the last /)
-	if len(apiKey) > 0 {
-		for i := len(apiKey) - 1; i >= 0; i-- {
-			if apiKey[i] == '/' {
-				apiKey = apiKey[i+1:]
-				break
-			}
+// GetNFTsForContractIterator returns an iterator for paginating through NFTs in a contract.
+func (c *Client) GetNFTsForContractIterator(ctx context.Context, params *NFTsForContractParams) *NFTsForContractIterator {
+	paramsCopy := *params
+	return &NFTsForContractIterator{
+		client: c,
+		params: &paramsCopy,
+		ctx:    ctx,
+	}
+}
+
+// NFTsForContractIterator iterates through NFTs in a contract with pagination.
+type NFTsForContractIterator struct {
+	client  *Client
+	params  *NFTsForContractParams
+	ctx     context.Context
+	current *NFTsForContractResponse
+	index   int
+	done    bool
+	err     error
+	mu      sync.Mutex
+}
+
+// Next returns the next NFT in the iteration.
+// Returns nil when there are no more NFTs.
+func (it *NFTsForContractIterator) Next() (*OwnedNFT, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	if it.done {
+		return nil, nil
+	}
+
+	if it.current == nil {
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return nil, err
 		}
 	}
 
-	fullURL := baseURL + "/" + apiKey + "/" + method
-	if len(query) > 0 {
-		fullURL = fullURL + "?" + query.Encode()
+	if it.index < len(it.current.NFTs) {
+		nft := &it.current.NFTs[it.index]
+		it.index++
+		return nft, nil
+	}
+
+	if !it.current.HasMore() {
+		it.done = true
+		return nil, nil
+	}
+
+	it.params.PageKey = it.current.PageKey
+	if err := it.fetchNext(); err != nil {
+		it.err = err
+		return nil, err
 	}
 
-	body, err := c.http.Get(ctx, fullURL)
+	if len(it.current.NFTs) == 0 {
+		it.done = true
+		return nil, nil
+	}
+
+	nft := &it.current.NFTs[0]
+	it.index = 1
+	return nft, nil
+}
+
+// HasNext returns true if there are more NFTs to iterate.
+func (it *NFTsForContractIterator) HasNext() bool {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.current != nil && it.index < len(it.current.NFTs) {
+		return true
+	}
+
+	if it.current != nil {
+		return it.current.HasMore()
+	}
+
+	return true
+}
+
+// Error returns any error encountered during iteration.
+func (it *NFTsForContractIterator) Error() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// Collect returns all remaining NFTs as a slice.
+// Use with caution on large collections.
+func (it *NFTsForContractIterator) Collect() ([]OwnedNFT, error) {
+	var nfts []OwnedNFT
+
+	for {
+		nft, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if nft == nil {
+			break
+		}
+		nfts = append(nfts, *nft)
+	}
+
+	return nfts, nil
+}
+
+func (it *NFTsForContractIterator) fetchNext() error {
+	result, err := it.client.GetNFTsForContract(it.ctx, it.params)
+	if err != nil {
+		return err
+	}
+	it.current = result
+	it.index = 0
+	return nil
+}
+
+// NFTsForContractResponse represents the response from getNFTsForContract.
+type NFTsForContractResponse struct {
+	// NFTs is the list of NFTs in the contract.
+	NFTs []OwnedNFT `json:"nfts"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+}
+
+// HasMore returns true if there are more results available.
+func (r *NFTsForContractResponse) HasMore() bool {
+	return r.PageKey != ""
+}
+
+// GetOwnersForNFT retrieves the first page of owners for a specific NFT.
+// Use GetOwnersForNFTWithParams for pagination.
+func (c *Client) GetOwnersForNFT(ctx context.Context, contractAddress types.Address, tokenID string) (*OwnersForNFTResponse, error) {
+	return c.GetOwnersForNFTWithParams(ctx, NewOwnersForNFTParams(contractAddress, tokenID))
+}
+
+// GetOwnersForNFTWithParams retrieves owners for a specific NFT.
+func (c *Client) GetOwnersForNFTWithParams(ctx context.Context, params *OwnersForNFTParams) (*OwnersForNFTResponse, error) {
+	query := url.Values{}
+	query.Set("contractAddress", params.ContractAddress.String())
+	query.Set("tokenId", params.TokenID)
+
+	if params.PageKey != "" {
+		query.Set("pageKey", params.PageKey)
+	}
+
+	if params.PageSize != nil {
+		query.Set("pageSize", fmt.Sprintf("%d", *params.PageSize))
+	}
+
+	var result OwnersForNFTResponse
+	if err := c.nftGet(ctx, "getOwnersForNFT", query, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAllOwnersForNFT retrieves all owners for a specific NFT (handles pagination).
+func (c *Client) GetAllOwnersForNFT(ctx context.Context, contractAddress types.Address, tokenID string) ([]types.Address, error) {
+	var allOwners []types.Address
+	params := NewOwnersForNFTParams(contractAddress, tokenID)
+
+	for {
+		resp, err := c.GetOwnersForNFTWithParams(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		allOwners = append(allOwners, resp.Owners...)
+
+		if !resp.HasMore() {
+			break
+		}
+		params.PageKey = resp.PageKey
+	}
+
+	return allOwners, nil
+}
+
+// OwnersForNFTResponse represents the response from getOwnersForNFT.
+type OwnersForNFTResponse struct {
+	// Owners is the list of owner addresses.
+	Owners []types.Address `json:"owners"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+}
+
+// HasMore returns true if there are more results available.
+func (r *OwnersForNFTResponse) HasMore() bool {
+	return r.PageKey != ""
+}
+
+// GetOwnersForContract retrieves all owners for an NFT contract.
+func (c *Client) GetOwnersForContract(ctx context.Context, contractAddress types.Address, pageKey string, withTokenBalances bool) (*OwnersForContractResponse, error) {
+	query := url.Values{}
+	query.Set("contractAddress", contractAddress.String())
+	query.Set("withTokenBalances", fmt.Sprintf("%t", withTokenBalances))
+
+	if pageKey != "" {
+		query.Set("pageKey", pageKey)
+	}
+
+	var result OwnersForContractResponse
+	if err := c.nftGet(ctx, "getOwnersForContract", query, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// OwnersForContractResponse represents the response from getOwnersForContract.
+type OwnersForContractResponse struct {
+	// Owners is the list of owners with their balances.
+	Owners []ContractOwner `json:"ownerAddresses"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+}
+
+// ContractOwner represents an owner of NFTs in a contract.
+type ContractOwner struct {
+	// OwnerAddress is the owner's address.
+	OwnerAddress types.Address `json:"ownerAddress"`
+	// TokenBalances contains the tokens owned (if withTokenBalances is true).
+	TokenBalances []TokenBalanceEntry `json:"tokenBalances,omitempty"`
+}
+
+// TokenBalanceEntry represents a token balance entry.
+type TokenBalanceEntry struct {
+	// TokenID is the token ID.
+	TokenID string `json:"tokenId"`
+	// Balance is the balance.
+	Balance string `json:"balance"`
+}
+
+// HasMore returns true if there are more results available.
+func (r *OwnersForContractResponse) HasMore() bool {
+	return r.PageKey != ""
+}
+
+// GetOwnersForContractIterator returns an iterator for paginating through contract owners.
+func (c *Client) GetOwnersForContractIterator(ctx context.Context, contractAddress types.Address, withTokenBalances bool) *OwnersForContractIterator {
+	return &OwnersForContractIterator{
+		client:            c,
+		contractAddress:   contractAddress,
+		withTokenBalances: withTokenBalances,
+		ctx:               ctx,
+	}
+}
+
+// OwnersForContractIterator iterates through contract owners with pagination.
+type OwnersForContractIterator struct {
+	client            *Client
+	contractAddress   types.Address
+	withTokenBalances bool
+	pageKey           string
+	ctx               context.Context
+	current           *OwnersForContractResponse
+	index             int
+	done              bool
+	err               error
+	mu                sync.Mutex
+}
+
+// Next returns the next owner in the iteration.
+// Returns nil when there are no more owners.
+func (it *OwnersForContractIterator) Next() (*ContractOwner, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	if it.done {
+		return nil, nil
+	}
+
+	if it.current == nil {
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return nil, err
+		}
+	}
+
+	if it.index < len(it.current.Owners) {
+		owner := &it.current.Owners[it.index]
+		it.index++
+		return owner, nil
+	}
+
+	if !it.current.HasMore() {
+		it.done = true
+		return nil, nil
+	}
+
+	it.pageKey = it.current.PageKey
+	if err := it.fetchNext(); err != nil {
+		it.err = err
+		return nil, err
+	}
+
+	if len(it.current.Owners) == 0 {
+		it.done = true
+		return nil, nil
+	}
+
+	owner := &it.current.Owners[0]
+	it.index = 1
+	return owner, nil
+}
+
+// HasNext returns true if there are more owners to iterate.
+func (it *OwnersForContractIterator) HasNext() bool {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.current != nil && it.index < len(it.current.Owners) {
+		return true
+	}
+
+	if it.current != nil {
+		return it.current.HasMore()
+	}
+
+	return true
+}
+
+// Error returns any error encountered during iteration.
+func (it *OwnersForContractIterator) Error() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// Collect returns all remaining owners as a slice.
+// Use with caution on large collections.
+func (it *OwnersForContractIterator) Collect() ([]ContractOwner, error) {
+	return it.CollectN(0)
+}
+
+// CollectN returns up to n owners. If n is zero or negative, all remaining owners are returned.
+func (it *OwnersForContractIterator) CollectN(n int) ([]ContractOwner, error) {
+	var owners []ContractOwner
+
+	for n <= 0 || len(owners) < n {
+		owner, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if owner == nil {
+			break
+		}
+		owners = append(owners, *owner)
+	}
+
+	return owners, nil
+}
+
+func (it *OwnersForContractIterator) fetchNext() error {
+	result, err := it.client.GetOwnersForContract(it.ctx, it.contractAddress, it.pageKey, it.withTokenBalances)
+	if err != nil {
+		return err
+	}
+	it.current = result
+	it.index = 0
+	return nil
+}
+
+// GetAllOwnersForContract retrieves all owners for an NFT contract (handles pagination).
+// If maxOwners is greater than zero, at most maxOwners owners are returned.
+func (c *Client) GetAllOwnersForContract(ctx context.Context, contractAddress types.Address, withTokenBalances bool, maxOwners int) ([]ContractOwner, error) {
+	return c.GetOwnersForContractIterator(ctx, contractAddress, withTokenBalances).CollectN(maxOwners)
+}
+
+// OwnerHolding summarizes the tokens held by a single owner.
+type OwnerHolding struct {
+	// Owner is the owner's address.
+	Owner types.Address
+	// TokenCount is the number of distinct token IDs held.
+	TokenCount int
+	// TotalBalance is the sum of balances across all token IDs.
+	TotalBalance *big.Int
+}
+
+// AggregateOwnerHoldings sums token balances per owner and returns the owners
+// sorted by the number of distinct tokens held in descending order, with
+// ties broken by total balance. The owners must have been fetched with token
+// balances; entries with unparseable or zero balances are skipped, and a
+// token listed more than once for an owner is counted once.
+func AggregateOwnerHoldings(owners []ContractOwner) []OwnerHolding {
+	byOwner := make(map[types.Address]*OwnerHolding)
+	held := make(map[types.Address]map[string]struct{})
+	var order []types.Address
+
+	for _, owner := range owners {
+		holding, ok := byOwner[owner.OwnerAddress]
+		if !ok {
+			holding = &OwnerHolding{
+				Owner:        owner.OwnerAddress,
+				TotalBalance: new(big.Int),
+			}
+			byOwner[owner.OwnerAddress] = holding
+			held[owner.OwnerAddress] = make(map[string]struct{})
+			order = append(order, owner.OwnerAddress)
+		}
+
+		for _, entry := range owner.TokenBalances {
+			balance, err := parseBigInt(entry.Balance)
+			if err != nil || balance.Sign() <= 0 {
+				continue
+			}
+			key := entry.TokenID
+			if id, err := ParseTokenID(entry.TokenID); err == nil {
+				key = id.Decimal()
+			}
+			if _, ok := held[owner.OwnerAddress][key]; ok {
+				continue
+			}
+			held[owner.OwnerAddress][key] = struct{}{}
+			holding.TokenCount++
+			holding.TotalBalance.Add(holding.TotalBalance, balance)
+		}
+	}
+
+	holdings := make([]OwnerHolding, 0, len(order))
+	for _, addr := range order {
+		holdings = append(holdings, *byOwner[addr])
+	}
+
+	sort.SliceStable(holdings, func(i, j int) bool {
+		if holdings[i].TokenCount != holdings[j].TokenCount {
+			return holdings[i].TokenCount > holdings[j].TokenCount
+		}
+		return holdings[i].TotalBalance.Cmp(holdings[j].TotalBalance) > 0
+	})
+
+	return holdings
+}
+
+// GetContractsForOwner retrieves the NFT contracts held by an owner.
+func (c *Client) GetContractsForOwner(ctx context.Context, params *ContractsForOwnerParams) (*ContractsForOwnerResponse, error) {
+	query := url.Values{}
+	query.Set("owner", params.Owner.String())
+
+	if params.WithMetadata != nil {
+		query.Set("withMetadata", fmt.Sprintf("%t", *params.WithMetadata))
+	}
+
+	if params.OrderBy != "" {
+		query.Set("orderBy", string(params.OrderBy))
+	}
+
+	for _, filter := range params.ExcludeFilters {
+		query.Add("excludeFilters[]", string(filter))
+	}
+
+	for _, filter := range params.IncludeFilters {
+		query.Add("includeFilters[]", string(filter))
+	}
+
+	if params.SpamConfidenceLevel != "" {
+		query.Set("spamConfidenceLevel", string(params.SpamConfidenceLevel))
+	}
+
+	if params.PageKey != "" {
+		query.Set("pageKey", params.PageKey)
+	}
+
+	if params.PageSize != nil {
+		query.Set("pageSize", fmt.Sprintf("%d", *params.PageSize))
+	}
+
+	var result ContractsForOwnerResponse
+	if err := c.nftGet(ctx, "getContractsForOwner", query, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetContractsForOwnerIterator returns an iterator for paginating through an owner's contracts.
+func (c *Client) GetContractsForOwnerIterator(ctx context.Context, params *ContractsForOwnerParams) *ContractsForOwnerIterator {
+	paramsCopy := *params
+	return &ContractsForOwnerIterator{
+		client: c,
+		params: &paramsCopy,
+		ctx:    ctx,
+	}
+}
+
+// ContractsForOwnerIterator iterates through an owner's contracts with pagination.
+type ContractsForOwnerIterator struct {
+	client  *Client
+	params  *ContractsForOwnerParams
+	ctx     context.Context
+	current *ContractsForOwnerResponse
+	index   int
+	done    bool
+	err     error
+	mu      sync.Mutex
+}
+
+// Next returns the next contract in the iteration.
+// Returns nil when there are no more contracts.
+func (it *ContractsForOwnerIterator) Next() (*OwnedContract, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	if it.done {
+		return nil, nil
+	}
+
+	if it.current == nil {
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return nil, err
+		}
+	}
+
+	if it.index < len(it.current.Contracts) {
+		contract := &it.current.Contracts[it.index]
+		it.index++
+		return contract, nil
+	}
+
+	if !it.current.HasMore() {
+		it.done = true
+		return nil, nil
+	}
+
+	it.params.PageKey = it.current.PageKey
+	if err := it.fetchNext(); err != nil {
+		it.err = err
+		return nil, err
+	}
+
+	if len(it.current.Contracts) == 0 {
+		it.done = true
+		return nil, nil
+	}
+
+	contract := &it.current.Contracts[0]
+	it.index = 1
+	return contract, nil
+}
+
+// HasNext returns true if there are more contracts to iterate.
+func (it *ContractsForOwnerIterator) HasNext() bool {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.current != nil && it.index < len(it.current.Contracts) {
+		return true
+	}
+
+	if it.current != nil {
+		return it.current.HasMore()
+	}
+
+	return true
+}
+
+// Error returns any error encountered during iteration.
+func (it *ContractsForOwnerIterator) Error() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// TotalCount returns the total count of contracts (available after first fetch).
+func (it *ContractsForOwnerIterator) TotalCount() int {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.current != nil {
+		return it.current.TotalCount
+	}
+	return 0
+}
+
+// Collect returns all remaining contracts as a slice.
+func (it *ContractsForOwnerIterator) Collect() ([]OwnedContract, error) {
+	var contracts []OwnedContract
+
+	for {
+		contract, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if contract == nil {
+			break
+		}
+		contracts = append(contracts, *contract)
+	}
+
+	return contracts, nil
+}
+
+func (it *ContractsForOwnerIterator) fetchNext() error {
+	result, err := it.client.GetContractsForOwner(it.ctx, it.params)
+	if err != nil {
+		return err
+	}
+	it.current = result
+	it.index = 0
+	return nil
+}
+
+// IsSpamContract checks if a contract is classified as spam.
+func (c *Client) IsSpamContract(ctx context.Context, contractAddress types.Address) (bool, error) {
+	query := url.Values{}
+	query.Set("contractAddress", contractAddress.String())
+
+	var result struct {
+		IsSpamContract bool `json:"isSpamContract"`
+	}
+	if err := c.nftGet(ctx, "isSpamContract", query, &result); err != nil {
+		return false, err
+	}
+	return result.IsSpamContract, nil
+}
+
+// nftGet makes a GET request to the NFT API endpoint.
+func (c *Client) nftGet(ctx context.Context, method string, query url.Values, result interface{}) error {
+	body, err := c.nftGetRaw(ctx, method, query)
+	if err != nil {
+		return err
+	}
+
+	return c.http.Unmarshal(body, result)
+}
+
+// nftGetRaw makes a GET request to the NFT API endpoint and returns the raw body.
+func (c *Client) nftGetRaw(ctx context.Context, method string, query url.Values) ([]byte, error) {
+	fullURL := c.nftEndpoint(method)
+	if len(query) > 0 {
+		fullURL = fullURL + "?" + query.Encode()
+	}
+
+	return c.http.GetURL(ctx, fullURL)
+}
+
+// nftPost makes a POST request with a JSON body to the NFT API endpoint.
+func (c *Client) nftPost(ctx context.Context, method string, body interface{}, result interface{}) error {
+	respBody, err := c.http.PostURL(ctx, c.nftEndpoint(method), body)
 	if err != nil {
 		return err
 	}
 
-	return json.Unmarshal(body, result)
+	return c.http.Unmarshal(respBody, result)
 }
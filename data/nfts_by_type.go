@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// maxNFTsForOwnerContracts is the maximum number of contract addresses per
+// getNFTsForOwner request.
+const maxNFTsForOwnerContracts = 45
+
+// GetNFTsForOwnerByType retrieves all NFTs of the given token type owned by
+// params.Owner. The NFT API cannot filter getNFTsForOwner by token type, so
+// the owner's contracts are listed first with getContractsForOwner and only
+// the contracts of tokenType are queried, 45 at a time. This avoids fetching
+// every NFT when the owner holds few tokens of the requested type.
+// params.ContractAddresses, if set, further restricts the contracts; filters,
+// metadata and page size options are applied to both calls where supported.
+func (c *Client) GetNFTsForOwnerByType(ctx context.Context, params *NFTsForOwnerParams, tokenType NFTTokenType) ([]OwnedNFT, error) {
+	contracts, err := c.ownerContractsOfType(ctx, params, tokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	var nfts []OwnedNFT
+	for start := 0; start < len(contracts); start += maxNFTsForOwnerContracts {
+		end := start + maxNFTsForOwnerContracts
+		if end > len(contracts) {
+			end = len(contracts)
+		}
+
+		chunkParams := *params
+		chunkParams.ContractAddresses = contracts[start:end]
+		chunkParams.PageKey = ""
+
+		chunk, err := c.GetNFTsForOwnerIterator(ctx, &chunkParams).Collect()
+		if err != nil {
+			return nil, err
+		}
+		for _, nft := range chunk {
+			if nft.TokenType == "" || strings.EqualFold(nft.TokenType, string(tokenType)) {
+				nfts = append(nfts, nft)
+			}
+		}
+	}
+
+	return nfts, nil
+}
+
+// ownerContractsOfType returns the addresses of the owner's contracts of
+// tokenType, restricted to params.ContractAddresses if set.
+func (c *Client) ownerContractsOfType(ctx context.Context, params *NFTsForOwnerParams, tokenType NFTTokenType) ([]types.Address, error) {
+	var allowed map[string]struct{}
+	if len(params.ContractAddresses) > 0 {
+		allowed = make(map[string]struct{}, len(params.ContractAddresses))
+		for _, addr := range params.ContractAddresses {
+			allowed[strings.ToLower(addr.String())] = struct{}{}
+		}
+	}
+
+	contractParams := NewContractsForOwnerParams(params.Owner)
+	contractParams.ExcludeFilters = params.ExcludeFilters
+	contractParams.IncludeFilters = params.IncludeFilters
+	contractParams.SpamConfidenceLevel = params.SpamConfidenceLevel
+
+	it := c.GetContractsForOwnerIterator(ctx, contractParams)
+	var contracts []types.Address
+	for {
+		contract, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if contract == nil {
+			break
+		}
+		if !strings.EqualFold(contract.TokenType, string(tokenType)) {
+			continue
+		}
+		if allowed != nil {
+			if _, ok := allowed[strings.ToLower(contract.Address.String())]; !ok {
+				continue
+			}
+		}
+		contracts = append(contracts, contract.Address)
+	}
+
+	return contracts, nil
+}
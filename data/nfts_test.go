@@ -0,0 +1,394 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// nftPage returns a getNFTsForOwner page with one NFT.
+func nftPage(tokenID string, block int, pageKey string) string {
+	return fmt.Sprintf(`{"ownedNfts":[{"contract":{"address":"0x0000000000000000000000000000000000000001"},"tokenId":%q}],`+
+		`"totalCount":2,"pageKey":%q,"validAt":{"blockNumber":%d}}`, tokenID, pageKey, block)
+}
+
+// newNFTPagesClient serves two getNFTsForOwner pages at the given blocks and
+// records the query strings it receives.
+func newNFTPagesClient(t *testing.T, firstBlock, secondBlock int) (*Client, func() []string) {
+	t.Helper()
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		mu.Unlock()
+		if r.URL.Query().Get("pageKey") == "" {
+			_, _ = w.Write([]byte(nftPage("1", firstBlock, "next")))
+			return
+		}
+		_, _ = w.Write([]byte(nftPage("2", secondBlock, "")))
+	})
+	return newTestClient(srv), func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), queries...)
+	}
+}
+
+func TestNFTsForOwnerIteratorValidAt(t *testing.T) {
+	c, queries := newNFTPagesClient(t, 100, 101)
+	owner := types.MustParseAddress("0x00000000000000000000000000000000000000aa")
+
+	params := NewNFTsForOwnerParams(owner).SetOrderBy(NFTOrderByTransferTime)
+	it := c.GetNFTsForOwnerIterator(context.Background(), params)
+	nfts, err := it.Collect()
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(nfts) != 2 {
+		t.Fatalf("Collect() returned %d NFTs, want 2", len(nfts))
+	}
+	if v := it.ValidAt(); v == nil || v.BlockNumber != 100 {
+		t.Errorf("ValidAt() = %+v, want block 100", v)
+	}
+
+	for _, q := range queries() {
+		values, err := url.ParseQuery(q)
+		if err != nil {
+			t.Fatalf("ParseQuery(%q) error = %v", q, err)
+		}
+		if values.Has("block") {
+			t.Errorf("query %q sends undocumented block parameter", q)
+		}
+		if got := values.Get("orderBy"); got != "transferTime" {
+			t.Errorf("query %q orderBy = %q, want transferTime", q, got)
+		}
+	}
+}
+
+func TestNFTsForOwnerIteratorConsistentSnapshot(t *testing.T) {
+	owner := types.MustParseAddress("0x00000000000000000000000000000000000000aa")
+
+	t.Run("same block", func(t *testing.T) {
+		c, _ := newNFTPagesClient(t, 100, 100)
+		it := c.GetNFTsForOwnerIterator(context.Background(), NewNFTsForOwnerParams(owner)).
+			SetRequireConsistentSnapshot(true)
+		nfts, err := it.Collect()
+		if err != nil || len(nfts) != 2 {
+			t.Fatalf("Collect() = %d NFTs, %v; want 2, nil", len(nfts), err)
+		}
+	})
+
+	t.Run("block changed", func(t *testing.T) {
+		c, _ := newNFTPagesClient(t, 100, 101)
+		it := c.GetNFTsForOwnerIterator(context.Background(), NewNFTsForOwnerParams(owner)).
+			SetRequireConsistentSnapshot(true)
+
+		first, err := it.Next()
+		if err != nil || first == nil || first.TokenID != "1" {
+			t.Fatalf("Next() = %+v, %v; want token 1", first, err)
+		}
+		if _, err := it.Next(); !errors.Is(err, ErrNFTSnapshotMismatch) {
+			t.Errorf("Next() error = %v, want ErrNFTSnapshotMismatch", err)
+		}
+		if it.HasNext() {
+			t.Error("HasNext() = true after snapshot mismatch")
+		}
+	})
+}
+
+func TestSortOwnedNFTs(t *testing.T) {
+	str := func(s string) *string { return &s }
+	nft := func(contract, tokenID string, name, acquired *string) OwnedNFT {
+		n := OwnedNFT{
+			Contract: NFTContract{Address: types.MustParseAddress(contract)},
+			TokenID:  tokenID,
+			Name:     name,
+		}
+		if acquired != nil {
+			n.AcquiredAt = &AcquiredAt{BlockTimestamp: acquired}
+		}
+		return n
+	}
+	a := "0x000000000000000000000000000000000000000a"
+	b := "0x000000000000000000000000000000000000000b"
+	nfts := []OwnedNFT{
+		nft(b, "10", str("beta"), str("2024-03-01T00:00:00Z")),
+		nft(a, "9", nil, nil),
+		nft(a, "0x10", str("Alpha"), str("2024-01-01T00:00:00Z")),
+		nft(b, "2", str("gamma"), str("2024-02-01T00:00:00Z")),
+	}
+
+	tests := []struct {
+		key   NFTSortKey
+		order SortOrder
+		want  []string
+	}{
+		{NFTSortByContract, SortAsc, []string{"9", "0x10", "2", "10"}},
+		{NFTSortByContract, SortDesc, []string{"10", "2", "0x10", "9"}},
+		{NFTSortByTokenID, "", []string{"2", "9", "10", "0x10"}},
+		{NFTSortByTokenID, SortDesc, []string{"0x10", "10", "9", "2"}},
+		{NFTSortByName, SortAsc, []string{"0x10", "10", "2", "9"}},
+		{NFTSortByName, SortDesc, []string{"2", "10", "0x10", "9"}},
+		{NFTSortByAcquiredAt, SortAsc, []string{"0x10", "2", "10", "9"}},
+		{NFTSortByAcquiredAt, SortDesc, []string{"10", "2", "0x10", "9"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s", tt.key, tt.order), func(t *testing.T) {
+			sorted := append([]OwnedNFT(nil), nfts...)
+			SortOwnedNFTs(sorted, tt.key, tt.order)
+
+			got := make([]string, len(sorted))
+			for i := range sorted {
+				got[i] = sorted[i].TokenID
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("token IDs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetContractMetadataBatchOrder(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		// Reply out of order, with different casing, omitting 0x...0c.
+		_, _ = w.Write([]byte(`{"contracts":[` +
+			`{"address":"0x000000000000000000000000000000000000000B","name":"B"},` +
+			`{"address":"0x000000000000000000000000000000000000000a","name":"A"}]}`))
+	})
+	c := newTestClient(srv)
+
+	a := types.MustParseAddress("0x000000000000000000000000000000000000000A")
+	b := types.MustParseAddress("0x000000000000000000000000000000000000000b")
+	missing := types.MustParseAddress("0x000000000000000000000000000000000000000c")
+
+	results, err := c.GetContractMetadataBatch(context.Background(), []types.Address{a, missing, b})
+	var missingErr *MissingContractMetadataError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("GetContractMetadataBatch() error = %v, want *MissingContractMetadataError", err)
+	}
+	if !reflect.DeepEqual(missingErr.Missing, []types.Address{missing}) {
+		t.Errorf("Missing = %v, want [%s]", missingErr.Missing, missing)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	names := make([]string, len(results))
+	for i, r := range results {
+		if r.Name != nil {
+			names[i] = *r.Name
+		}
+	}
+	if !reflect.DeepEqual(names, []string{"A", "", "B"}) {
+		t.Errorf("names = %q, want [A  B]", names)
+	}
+	if results[1].Address != missing {
+		t.Errorf("missing entry Address = %s, want %s", results[1].Address, missing)
+	}
+}
+
+// baycAddress is the contract of the recorded getNFTsForContract pages.
+const baycAddress = "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d"
+
+// newNFTsForContractClient serves the recorded getNFTsForContract pages,
+// choosing the page by the pageKey query parameter.
+func newNFTsForContractClient(t *testing.T) (*Client, *[]map[string][]string) {
+	t.Helper()
+	pages := make(map[string][]byte)
+	for key, file := range map[string]string{
+		"": "testdata/nfts_for_contract_page1.json",
+		"0x0000000000000000000000000000000000000000000000000000000000000002": "testdata/nfts_for_contract_page2.json",
+	} {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("ReadFile(%s) error = %v", file, err)
+		}
+		pages[key] = b
+	}
+
+	var queries []map[string][]string
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		queries = append(queries, query)
+		page, ok := pages[query.Get("pageKey")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write(page)
+	})
+	return newTestClient(srv), &queries
+}
+
+func TestGetNFTsForContractParams(t *testing.T) {
+	c, queries := newNFTsForContractClient(t)
+	params := NewNFTsForContractParams(types.MustParseAddress(baycAddress)).
+		SetWithMetadata(false).
+		SetLimit(2).
+		SetTokenURITimeoutInMs(500)
+
+	resp, err := c.GetNFTsForContract(context.Background(), params)
+	if err != nil {
+		t.Fatalf("GetNFTsForContract() error = %v", err)
+	}
+	if len(resp.NFTs) != 2 || !resp.HasMore() {
+		t.Fatalf("GetNFTsForContract() = %d NFTs, HasMore %v; want 2, true", len(resp.NFTs), resp.HasMore())
+	}
+
+	want := map[string][]string{
+		"contractAddress":     {baycAddress},
+		"withMetadata":        {"false"},
+		"limit":               {"2"},
+		"tokenUriTimeoutInMs": {"500"},
+	}
+	if got := (*queries)[0]; !reflect.DeepEqual(got, want) {
+		t.Errorf("query = %v, want %v", got, want)
+	}
+}
+
+func TestNFTsForContractIteratorPages(t *testing.T) {
+	c, queries := newNFTsForContractClient(t)
+	params := NewNFTsForContractParams(types.MustParseAddress(baycAddress)).SetLimit(2)
+	it := c.GetNFTsForContractIterator(context.Background(), params)
+
+	nfts, err := it.Collect()
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	got := make([]string, len(nfts))
+	for i := range nfts {
+		got[i] = nfts[i].TokenID
+	}
+	if want := []string{"0", "1", "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("token IDs = %v, want %v", got, want)
+	}
+	if it.HasNext() {
+		t.Error("HasNext() = true after the last page")
+	}
+
+	if len(*queries) != 2 {
+		t.Fatalf("made %d requests, want 2", len(*queries))
+	}
+	second := (*queries)[1]
+	if got := second["pageKey"]; len(got) != 1 || got[0] != "0x0000000000000000000000000000000000000000000000000000000000000002" {
+		t.Errorf("second request pageKey = %v, want the first page's key", got)
+	}
+	if _, ok := second["startToken"]; ok {
+		t.Error("second request sends startToken")
+	}
+	if got := second["limit"]; len(got) != 1 || got[0] != "2" {
+		t.Errorf("second request limit = %v, want [2]", got)
+	}
+	if params.PageKey != "" {
+		t.Errorf("iterator modified the caller's params: PageKey = %q", params.PageKey)
+	}
+}
+
+func TestAggregateOwnerHoldings(t *testing.T) {
+	a := types.MustParseAddress("0x000000000000000000000000000000000000000a")
+	b := types.MustParseAddress("0x000000000000000000000000000000000000000b")
+	c := types.MustParseAddress("0x000000000000000000000000000000000000000c")
+	balances := func(pairs ...string) []TokenBalanceEntry {
+		entries := make([]TokenBalanceEntry, 0, len(pairs)/2)
+		for i := 0; i < len(pairs); i += 2 {
+			entries = append(entries, TokenBalanceEntry{TokenID: pairs[i], Balance: pairs[i+1]})
+		}
+		return entries
+	}
+
+	holdings := AggregateOwnerHoldings([]ContractOwner{
+		// a holds one token with a large balance.
+		{OwnerAddress: a, TokenBalances: balances("1", "1000")},
+		// b holds two tokens; "0x2" repeats "2" and the zero balance is ignored.
+		{OwnerAddress: b, TokenBalances: balances("2", "1", "3", "1", "4", "0")},
+		{OwnerAddress: b, TokenBalances: balances("0x2", "1")},
+		// c holds two tokens with a larger total than b.
+		{OwnerAddress: c, TokenBalances: balances("5", "3", "6", "bad", "7", "4")},
+	})
+
+	type summary struct {
+		owner   types.Address
+		count   int
+		balance string
+	}
+	got := make([]summary, len(holdings))
+	for i, h := range holdings {
+		got[i] = summary{h.Owner, h.TokenCount, h.TotalBalance.String()}
+	}
+	want := []summary{{c, 2, "7"}, {b, 2, "2"}, {a, 1, "1000"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AggregateOwnerHoldings() = %+v, want %+v", got, want)
+	}
+}
+
+func TestWaitForNFTMetadataUpdateDeadline(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testNFTMetadata))
+	})
+	c := newTestClient(srv)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	contract := types.MustParseAddress("0x0000000000000000000000000000000000000001")
+	_, err := c.WaitForNFTMetadataUpdate(ctx, contract, "1", nil, time.Millisecond)
+	if !errors.Is(err, sdkerrors.ErrContextDeadline) {
+		t.Errorf("WaitForNFTMetadataUpdate() error = %v, want ErrContextDeadline", err)
+	}
+}
+
+func TestRefreshNFTMetadata(t *testing.T) {
+	const refreshed = `{"contract":{"address":"0x0000000000000000000000000000000000000001"},"tokenId":"1","timeLastUpdated":"2026-01-02T00:00:00Z"}`
+	const cached = `{"contract":{"address":"0x0000000000000000000000000000000000000001"},"tokenId":"1","timeLastUpdated":"2026-01-01T00:00:00Z"}`
+	queued := false
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/getNFTMetadata"):
+			_, _ = w.Write([]byte(cached))
+		case queued:
+			_, _ = w.Write([]byte(`{"status":"queued"}`))
+		default:
+			_, _ = w.Write([]byte(refreshed))
+		}
+	})
+	c := newTestClient(srv)
+	contract := types.MustParseAddress("0x0000000000000000000000000000000000000001")
+
+	nft, err := c.RefreshNFTMetadata(context.Background(), contract, "1")
+	if err != nil || nft == nil || *nft.TimeLastUpdated != "2026-01-02T00:00:00Z" {
+		t.Fatalf("RefreshNFTMetadata() = %+v, %v; want refreshed metadata", nft, err)
+	}
+	if paths := srv.Paths(); len(paths) != 1 || !strings.HasSuffix(paths[0], "/refreshNftMetadata") {
+		t.Errorf("RefreshNFTMetadata() requests = %v, want only refreshNftMetadata", paths)
+	}
+
+	params := NewRefreshNFTMetadataParams(contract, "1").SetCompareWithPrevious(true)
+	result, err := c.RefreshNFTMetadataWithParams(context.Background(), params)
+	if err != nil || !result.Refreshed || *result.PreviousTimeLastUpdated != "2026-01-01T00:00:00Z" {
+		t.Errorf("RefreshNFTMetadataWithParams(compare) = %+v, %v; want refreshed with previous time", result, err)
+	}
+
+	queued = true
+	nft, err = c.RefreshNFTMetadata(context.Background(), contract, "1")
+	if err != nil || nft != nil {
+		t.Errorf("RefreshNFTMetadata() when queued = %+v, %v; want nil, nil", nft, err)
+	}
+	result, err = c.RefreshNFTMetadataWithParams(context.Background(), NewRefreshNFTMetadataParams(contract, "1").SetFallbackToCached(true))
+	if err != nil || result.Refreshed || !result.Cached || result.NFT == nil || result.PreviousTimeLastUpdated != nil {
+		t.Errorf("RefreshNFTMetadataWithParams(fallback) when queued = %+v, %v; want cached metadata", result, err)
+	}
+}
@@ -1,6 +1,12 @@
 package data
 
 import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/ABT-Tech-Limited/alchemy-go/types"
 )
 
@@ -27,11 +33,28 @@ const (
 // NFTOrderBy represents the ordering for NFT results.
 type NFTOrderBy string
 
-// NFT order options.
+// NFT order options. Transfer time is the only ordering the API supports;
+// use SortOwnedNFTs to order fetched NFTs by other fields.
 const (
 	NFTOrderByTransferTime NFTOrderBy = "transferTime"
 )
 
+// NFTSortKey represents a field SortOwnedNFTs orders by.
+type NFTSortKey string
+
+// NFT sort keys.
+const (
+	// NFTSortByContract orders by contract address, then token ID.
+	NFTSortByContract NFTSortKey = "contract"
+	// NFTSortByTokenID orders by numeric token ID, then contract address.
+	NFTSortByTokenID NFTSortKey = "tokenId"
+	// NFTSortByName orders by NFT name, case-insensitively.
+	NFTSortByName NFTSortKey = "name"
+	// NFTSortByAcquiredAt orders by acquisition time. AcquiredAt is only
+	// populated when the NFTs were fetched with NFTOrderByTransferTime.
+	NFTSortByAcquiredAt NFTSortKey = "acquiredAt"
+)
+
 // NFTTokenType represents the type of NFT token.
 type NFTTokenType string
 
@@ -84,6 +107,13 @@ func (p *NFTsForOwnerParams) SetWithMetadata(withMetadata bool) *NFTsForOwnerPar
 	return p
 }
 
+// SetSpamConfidenceLevel sets the spam detection threshold used by the
+// SPAM exclude filter.
+func (p *NFTsForOwnerParams) SetSpamConfidenceLevel(level SpamConfidenceLevel) *NFTsForOwnerParams {
+	p.SpamConfidenceLevel = level
+	return p
+}
+
 // SetOrderBy sets the ordering.
 func (p *NFTsForOwnerParams) SetOrderBy(orderBy NFTOrderBy) *NFTsForOwnerParams {
 	p.OrderBy = orderBy
@@ -147,6 +177,115 @@ type OwnedNFT struct {
 	Balance *string `json:"balance,omitempty"`
 }
 
+// TokenIDValue returns the parsed token ID.
+func (n *OwnedNFT) TokenIDValue() (TokenID, bool) {
+	return optionalTokenID(&n.TokenID)
+}
+
+// IsLikelySpam returns true if the NFT's contract is likely spam.
+func (n *OwnedNFT) IsLikelySpam() bool {
+	return n.Contract.IsLikelySpam()
+}
+
+// FilterSpam returns the NFTs that are not likely spam.
+func FilterSpam(nfts []OwnedNFT) []OwnedNFT {
+	filtered := make([]OwnedNFT, 0, len(nfts))
+	for i := range nfts {
+		if !nfts[i].IsLikelySpam() {
+			filtered = append(filtered, nfts[i])
+		}
+	}
+	return filtered
+}
+
+// SortOwnedNFTs sorts nfts in place by key in the given order; an empty
+// order sorts ascending. NFTs missing the sort field are placed last in
+// either order, and ties keep their original order.
+func SortOwnedNFTs(nfts []OwnedNFT, key NFTSortKey, order SortOrder) {
+	desc := order == SortDesc
+	sort.SliceStable(nfts, func(i, j int) bool {
+		c, ok := compareOwnedNFTs(&nfts[i], &nfts[j], key)
+		if !ok {
+			return c < 0
+		}
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+// compareOwnedNFTs compares a and b by key. ok is false if the result only
+// reflects that one of them is missing the sort field, which is not reversed
+// for descending order.
+func compareOwnedNFTs(a, b *OwnedNFT, key NFTSortKey) (c int, ok bool) {
+	switch key {
+	case NFTSortByContract:
+		if c := strings.Compare(strings.ToLower(a.Contract.Address.String()), strings.ToLower(b.Contract.Address.String())); c != 0 {
+			return c, true
+		}
+		return compareNFTTokenIDs(a, b), true
+	case NFTSortByTokenID:
+		if c := compareNFTTokenIDs(a, b); c != 0 {
+			return c, true
+		}
+		return strings.Compare(strings.ToLower(a.Contract.Address.String()), strings.ToLower(b.Contract.Address.String())), true
+	case NFTSortByName:
+		if c, present := compareMissing(a.Name == nil, b.Name == nil); !present {
+			return c, false
+		}
+		return strings.Compare(strings.ToLower(*a.Name), strings.ToLower(*b.Name)), true
+	case NFTSortByAcquiredAt:
+		at, bt := acquiredAtTimestamp(a), acquiredAtTimestamp(b)
+		if c, present := compareMissing(at == "", bt == ""); !present {
+			return c, false
+		}
+		return strings.Compare(at, bt), true
+	default:
+		return 0, true
+	}
+}
+
+// compareMissing orders present values before missing ones. present is true
+// if both values are present.
+func compareMissing(aMissing, bMissing bool) (c int, present bool) {
+	switch {
+	case aMissing && bMissing:
+		return 0, false
+	case aMissing:
+		return 1, false
+	case bMissing:
+		return -1, false
+	default:
+		return 0, true
+	}
+}
+
+// compareNFTTokenIDs compares token IDs numerically. Unparsable IDs sort
+// after valid ones, by their string value.
+func compareNFTTokenIDs(a, b *OwnedNFT) int {
+	aID, aErr := ParseTokenID(a.TokenID)
+	bID, bErr := ParseTokenID(b.TokenID)
+	switch {
+	case aErr == nil && bErr == nil:
+		return aID.BigInt().Cmp(bID.BigInt())
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a.TokenID, b.TokenID)
+	}
+}
+
+// acquiredAtTimestamp returns the RFC 3339 acquisition timestamp, or "".
+func acquiredAtTimestamp(n *OwnedNFT) string {
+	if n.AcquiredAt == nil || n.AcquiredAt.BlockTimestamp == nil {
+		return ""
+	}
+	return *n.AcquiredAt.BlockTimestamp
+}
+
 // NFTContract represents NFT contract information.
 type NFTContract struct {
 	// Address is the contract address.
@@ -171,6 +310,15 @@ type NFTContract struct {
 	SpamClassifications []string `json:"spamClassifications,omitempty"`
 }
 
+// IsLikelySpam returns true if the contract is flagged as spam or has any
+// spam classifications.
+func (c *NFTContract) IsLikelySpam() bool {
+	if c.IsSpam != nil && *c.IsSpam {
+		return true
+	}
+	return len(c.SpamClassifications) > 0
+}
+
 // OpenSeaMetadata contains OpenSea-specific metadata.
 type OpenSeaMetadata struct {
 	// FloorPrice is the floor price.
@@ -211,6 +359,28 @@ type NFTImage struct {
 	OriginalURL *string `json:"originalUrl,omitempty"`
 }
 
+// BestDisplayURL returns the best URL for displaying the full image,
+// preferring cached, then PNG, then original.
+func (i *NFTImage) BestDisplayURL() (string, bool) {
+	return firstURL(i.CachedURL, i.PngURL, i.OriginalURL)
+}
+
+// BestThumbnailURL returns the best URL for displaying a thumbnail,
+// preferring thumbnail, then cached, then PNG, then original.
+func (i *NFTImage) BestThumbnailURL() (string, bool) {
+	return firstURL(i.ThumbnailURL, i.CachedURL, i.PngURL, i.OriginalURL)
+}
+
+// firstURL returns the first non-nil, non-empty URL.
+func firstURL(urls ...*string) (string, bool) {
+	for _, u := range urls {
+		if u != nil && *u != "" {
+			return *u, true
+		}
+	}
+	return "", false
+}
+
 // NFTRaw contains raw NFT data.
 type NFTRaw struct {
 	// TokenURI is the raw token URI.
@@ -237,6 +407,46 @@ type NFTRawMetadata struct {
 	AnimationURL *string `json:"animation_url,omitempty"`
 }
 
+// Attribute returns the first attribute whose trait type matches traitType,
+// ignoring case and surrounding whitespace.
+func (m *NFTRawMetadata) Attribute(traitType string) (*NFTAttribute, bool) {
+	want := strings.TrimSpace(traitType)
+	for i := range m.Attributes {
+		if strings.EqualFold(m.Attributes[i].Trait(), want) {
+			return &m.Attributes[i], true
+		}
+	}
+	return nil, false
+}
+
+// AttributeMap returns the attributes keyed by trait type.
+// Attributes without a trait type are omitted; if a trait type appears more
+// than once, the first occurrence wins.
+func (m *NFTRawMetadata) AttributeMap() map[string]NFTAttribute {
+	attrs := make(map[string]NFTAttribute, len(m.Attributes))
+	for _, attr := range m.Attributes {
+		trait := attr.Trait()
+		if trait == "" {
+			continue
+		}
+		if _, ok := attrs[trait]; !ok {
+			attrs[trait] = attr
+		}
+	}
+	return attrs
+}
+
+// NFTDisplayType represents the OpenSea display_type of an attribute.
+type NFTDisplayType string
+
+// OpenSea attribute display types.
+const (
+	NFTDisplayTypeNumber          NFTDisplayType = "number"
+	NFTDisplayTypeBoostNumber     NFTDisplayType = "boost_number"
+	NFTDisplayTypeBoostPercentage NFTDisplayType = "boost_percentage"
+	NFTDisplayTypeDate            NFTDisplayType = "date"
+)
+
 // NFTAttribute represents an NFT attribute.
 type NFTAttribute struct {
 	// TraitType is the trait type.
@@ -247,6 +457,96 @@ type NFTAttribute struct {
 	DisplayType *string `json:"display_type,omitempty"`
 }
 
+// Trait returns the trait type, or an empty string if it is not set.
+func (a *NFTAttribute) Trait() string {
+	if a.TraitType == nil {
+		return ""
+	}
+	return strings.TrimSpace(*a.TraitType)
+}
+
+// Display returns the normalized display type, or an empty string if it is not set.
+func (a *NFTAttribute) Display() NFTDisplayType {
+	if a.DisplayType == nil {
+		return ""
+	}
+	return NFTDisplayType(strings.ToLower(strings.TrimSpace(*a.DisplayType)))
+}
+
+// IsNumeric returns true if the attribute is a numeric trait, either by its
+// display type (number, boost, date) or because its value is a number.
+func (a *NFTAttribute) IsNumeric() bool {
+	switch a.Display() {
+	case NFTDisplayTypeNumber, NFTDisplayTypeBoostNumber, NFTDisplayTypeBoostPercentage, NFTDisplayTypeDate:
+		return true
+	}
+	switch a.Value.(type) {
+	case float64, json.Number:
+		return true
+	}
+	return false
+}
+
+// IsBoost returns true if the attribute is an OpenSea boost trait.
+func (a *NFTAttribute) IsBoost() bool {
+	d := a.Display()
+	return d == NFTDisplayTypeBoostNumber || d == NFTDisplayTypeBoostPercentage
+}
+
+// StringValue returns the attribute value as a string.
+// Numeric and boolean values are formatted in their canonical form.
+func (a *NFTAttribute) StringValue() (string, bool) {
+	switch v := a.Value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// FloatValue returns the attribute value as a float64.
+// Numeric strings are parsed.
+func (a *NFTAttribute) FloatValue() (float64, bool) {
+	switch v := a.Value.(type) {
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case string:
+		v = strings.TrimSpace(v)
+		if a.Display() == NFTDisplayTypeBoostPercentage {
+			v = strings.TrimSuffix(v, "%")
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// IntValue returns the attribute value as an int64.
+// Returns false if the value is not a whole number.
+func (a *NFTAttribute) IntValue() (int64, bool) {
+	if s, ok := a.Value.(string); ok {
+		if n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+			return n, true
+		}
+	}
+
+	f, ok := a.FloatValue()
+	if !ok || f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
 // NFTCollection contains collection information.
 type NFTCollection struct {
 	// Name is the collection name.
@@ -309,6 +609,152 @@ func (p *NFTMetadataParams) SetRefreshCache(refresh bool) *NFTMetadataParams {
 	return p
 }
 
+// RefreshNFTMetadataParams represents the parameters for RefreshNFTMetadata.
+type RefreshNFTMetadataParams struct {
+	// ContractAddress is the NFT contract address.
+	ContractAddress types.Address `json:"contractAddress"`
+	// TokenID is the token ID.
+	TokenID string `json:"tokenId"`
+	// CompareWithPrevious reads the metadata before the refresh to set the
+	// result's PreviousTimeLastUpdated and compare it with the refreshed one.
+	CompareWithPrevious bool `json:"-"`
+	// FallbackToCached returns the cached metadata when the refresh is only
+	// queued. The result's Cached field is set in that case.
+	FallbackToCached bool `json:"-"`
+}
+
+// NewRefreshNFTMetadataParams creates new RefreshNFTMetadataParams.
+func NewRefreshNFTMetadataParams(contractAddress types.Address, tokenID string) *RefreshNFTMetadataParams {
+	return &RefreshNFTMetadataParams{
+		ContractAddress: contractAddress,
+		TokenID:         tokenID,
+	}
+}
+
+// SetCompareWithPrevious enables reading the metadata before the refresh.
+func (p *RefreshNFTMetadataParams) SetCompareWithPrevious(compare bool) *RefreshNFTMetadataParams {
+	p.CompareWithPrevious = compare
+	return p
+}
+
+// SetFallbackToCached enables returning cached metadata for a queued refresh.
+func (p *RefreshNFTMetadataParams) SetFallbackToCached(fallback bool) *RefreshNFTMetadataParams {
+	p.FallbackToCached = fallback
+	return p
+}
+
+// NFTRefreshResult is the result of RefreshNFTMetadataWithParams.
+type NFTRefreshResult struct {
+	// NFT is the metadata returned by the refresh request. It is nil when the
+	// refresh was queued, unless FallbackToCached was set.
+	NFT *OwnedNFT
+	// PreviousTimeLastUpdated is TimeLastUpdated before the refresh. It is
+	// only set with CompareWithPrevious.
+	PreviousTimeLastUpdated *string
+	// Refreshed is true if the refresh was applied immediately; with
+	// CompareWithPrevious, only if TimeLastUpdated also moved. When false the
+	// refresh is queued.
+	Refreshed bool
+	// Cached is true if NFT is the cached metadata rather than the result of
+	// the refresh.
+	Cached bool
+}
+
+// RefreshContractResult is the response from refreshContract.
+type RefreshContractResult struct {
+	// ContractAddress is the contract being refreshed.
+	ContractAddress types.Address `json:"contractAddress"`
+	// RefreshState is the state of the refresh, e.g. "queued" or "in_progress".
+	RefreshState string `json:"refreshState"`
+	// Progress is the refresh progress as a percentage, if reported.
+	Progress *string `json:"progress,omitempty"`
+}
+
+// InvalidateContractResult is the response from invalidateContract.
+type InvalidateContractResult struct {
+	// Success indicates whether the invalidation was accepted.
+	Success bool `json:"success"`
+	// NumTokensInvalidated is the number of tokens whose cache was cleared.
+	NumTokensInvalidated int `json:"numTokensInvalidated"`
+}
+
+// NFTsForContractParams represents the parameters for getNFTsForContract.
+type NFTsForContractParams struct {
+	// ContractAddress is the NFT contract address.
+	ContractAddress types.Address `json:"contractAddress"`
+	// WithMetadata includes NFT metadata in the response.
+	WithMetadata *bool `json:"withMetadata,omitempty"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+	// Limit is the number of results per page (max 100).
+	Limit *int `json:"limit,omitempty"`
+	// TokenURITimeoutInMs sets the timeout for fetching token URIs.
+	TokenURITimeoutInMs *int `json:"tokenUriTimeoutInMs,omitempty"`
+}
+
+// NewNFTsForContractParams creates new NFTsForContractParams.
+func NewNFTsForContractParams(contractAddress types.Address) *NFTsForContractParams {
+	return &NFTsForContractParams{
+		ContractAddress: contractAddress,
+	}
+}
+
+// SetWithMetadata enables metadata in the response.
+func (p *NFTsForContractParams) SetWithMetadata(withMetadata bool) *NFTsForContractParams {
+	p.WithMetadata = &withMetadata
+	return p
+}
+
+// SetPageKey sets the pagination key.
+func (p *NFTsForContractParams) SetPageKey(pageKey string) *NFTsForContractParams {
+	p.PageKey = pageKey
+	return p
+}
+
+// SetLimit sets the number of results per page.
+func (p *NFTsForContractParams) SetLimit(limit int) *NFTsForContractParams {
+	p.Limit = &limit
+	return p
+}
+
+// SetTokenURITimeoutInMs sets the timeout for fetching token URIs.
+func (p *NFTsForContractParams) SetTokenURITimeoutInMs(timeout int) *NFTsForContractParams {
+	p.TokenURITimeoutInMs = &timeout
+	return p
+}
+
+// OwnersForNFTParams represents the parameters for getOwnersForNFT.
+type OwnersForNFTParams struct {
+	// ContractAddress is the NFT contract address.
+	ContractAddress types.Address `json:"contractAddress"`
+	// TokenID is the token ID.
+	TokenID string `json:"tokenId"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+	// PageSize is the number of results per page.
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// NewOwnersForNFTParams creates new OwnersForNFTParams.
+func NewOwnersForNFTParams(contractAddress types.Address, tokenID string) *OwnersForNFTParams {
+	return &OwnersForNFTParams{
+		ContractAddress: contractAddress,
+		TokenID:         tokenID,
+	}
+}
+
+// SetPageKey sets the pagination key.
+func (p *OwnersForNFTParams) SetPageKey(pageKey string) *OwnersForNFTParams {
+	p.PageKey = pageKey
+	return p
+}
+
+// SetPageSize sets the page size.
+func (p *OwnersForNFTParams) SetPageSize(size int) *OwnersForNFTParams {
+	p.PageSize = &size
+	return p
+}
+
 // NFTContractMetadata represents contract-level NFT metadata.
 type NFTContractMetadata struct {
 	// Address is the contract address.
@@ -328,3 +774,125 @@ type NFTContractMetadata struct {
 	// OpenSeaMetadata contains OpenSea metadata.
 	OpenSeaMetadata *OpenSeaMetadata `json:"openseaMetadata,omitempty"`
 }
+
+// ContractsForOwnerParams represents the parameters for getContractsForOwner.
+type ContractsForOwnerParams struct {
+	// Owner is the address of the NFT owner.
+	Owner types.Address `json:"owner"`
+	// WithMetadata includes contract metadata in the response.
+	WithMetadata *bool `json:"withMetadata,omitempty"`
+	// OrderBy specifies the ordering of results.
+	OrderBy NFTOrderBy `json:"orderBy,omitempty"`
+	// ExcludeFilters excludes contracts matching these filters.
+	ExcludeFilters []NFTFilter `json:"excludeFilters,omitempty"`
+	// IncludeFilters includes only contracts matching these filters.
+	IncludeFilters []NFTFilter `json:"includeFilters,omitempty"`
+	// SpamConfidenceLevel sets the spam detection threshold.
+	SpamConfidenceLevel SpamConfidenceLevel `json:"spamConfidenceLevel,omitempty"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+	// PageSize is the number of results per page (max 100).
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// NewContractsForOwnerParams creates new ContractsForOwnerParams.
+func NewContractsForOwnerParams(owner types.Address) *ContractsForOwnerParams {
+	return &ContractsForOwnerParams{
+		Owner: owner,
+	}
+}
+
+// SetWithMetadata enables metadata in the response.
+func (p *ContractsForOwnerParams) SetWithMetadata(withMetadata bool) *ContractsForOwnerParams {
+	p.WithMetadata = &withMetadata
+	return p
+}
+
+// SetOrderBy sets the ordering.
+func (p *ContractsForOwnerParams) SetOrderBy(orderBy NFTOrderBy) *ContractsForOwnerParams {
+	p.OrderBy = orderBy
+	return p
+}
+
+// SetExcludeFilters sets exclusion filters.
+func (p *ContractsForOwnerParams) SetExcludeFilters(filters []NFTFilter) *ContractsForOwnerParams {
+	p.ExcludeFilters = filters
+	return p
+}
+
+// SetIncludeFilters sets inclusion filters.
+func (p *ContractsForOwnerParams) SetIncludeFilters(filters []NFTFilter) *ContractsForOwnerParams {
+	p.IncludeFilters = filters
+	return p
+}
+
+// SetSpamConfidenceLevel sets the spam detection threshold.
+func (p *ContractsForOwnerParams) SetSpamConfidenceLevel(level SpamConfidenceLevel) *ContractsForOwnerParams {
+	p.SpamConfidenceLevel = level
+	return p
+}
+
+// SetPageKey sets the pagination key.
+func (p *ContractsForOwnerParams) SetPageKey(pageKey string) *ContractsForOwnerParams {
+	p.PageKey = pageKey
+	return p
+}
+
+// SetPageSize sets the page size.
+func (p *ContractsForOwnerParams) SetPageSize(size int) *ContractsForOwnerParams {
+	p.PageSize = &size
+	return p
+}
+
+// ContractsForOwnerResponse represents the response from getContractsForOwner.
+type ContractsForOwnerResponse struct {
+	// Contracts is the list of contracts held by the owner.
+	Contracts []OwnedContract `json:"contracts"`
+	// TotalCount is the total number of contracts.
+	TotalCount int `json:"totalCount"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+}
+
+// HasMore returns true if there are more results available.
+func (r *ContractsForOwnerResponse) HasMore() bool {
+	return r.PageKey != ""
+}
+
+// OwnedContract represents an NFT contract held by an owner.
+type OwnedContract struct {
+	// Address is the contract address.
+	Address types.Address `json:"address"`
+	// Name is the contract name.
+	Name *string `json:"name,omitempty"`
+	// Symbol is the contract symbol.
+	Symbol *string `json:"symbol,omitempty"`
+	// TotalSupply is the total supply.
+	TotalSupply *string `json:"totalSupply,omitempty"`
+	// TokenType is the token type.
+	TokenType string `json:"tokenType"`
+	// ContractDeployer is the deployer address.
+	ContractDeployer *string `json:"contractDeployer,omitempty"`
+	// DeployedBlockNumber is the deployment block number.
+	DeployedBlockNumber *int64 `json:"deployedBlockNumber,omitempty"`
+	// OpenSeaMetadata contains OpenSea metadata.
+	OpenSeaMetadata *OpenSeaMetadata `json:"openseaMetadata,omitempty"`
+	// TotalBalance is the number of tokens the owner holds in the contract.
+	TotalBalance string `json:"totalBalance"`
+	// NumDistinctTokensOwned is the number of distinct token IDs owned.
+	NumDistinctTokensOwned string `json:"numDistinctTokensOwned"`
+	// IsSpam indicates if the contract is marked as spam.
+	IsSpam bool `json:"isSpam"`
+	// DisplayNFT is a representative NFT from the contract.
+	DisplayNFT *DisplayNFT `json:"displayNft,omitempty"`
+	// Image contains the display image of the representative NFT.
+	Image *NFTImage `json:"image,omitempty"`
+}
+
+// DisplayNFT identifies a representative NFT for a contract.
+type DisplayNFT struct {
+	// TokenID is the token ID.
+	TokenID string `json:"tokenId"`
+	// Name is the NFT name.
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,129 @@
+package data
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// testAttributesMetadata holds attributes in the shapes seen in the wild.
+const testAttributesMetadata = `{"attributes":[
+	{"trait_type":"Background","value":"Blue"},
+	{"trait_type":" Level ","value":"7"},
+	{"trait_type":"Speed","value":2.5},
+	{"trait_type":"Generation","value":3,"display_type":"number"},
+	{"trait_type":"Stamina Increase","value":10,"display_type":"boost_number"},
+	{"trait_type":"Power Boost","value":"15%","display_type":"Boost_Percentage"},
+	{"trait_type":"Birthday","value":1546360800,"display_type":"date"},
+	{"trait_type":"Legendary","value":true},
+	{"trait_type":"Empty"},
+	{"value":"orphan"},
+	{"trait_type":"background","value":"Red"}
+]}`
+
+func TestNFTRawMetadataAttribute(t *testing.T) {
+	var m NFTRawMetadata
+	if err := json.Unmarshal([]byte(testAttributesMetadata), &m); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	tests := []struct {
+		trait string
+		want  string
+		ok    bool
+	}{
+		{"Background", "Blue", true},
+		{"BACKGROUND", "Blue", true},
+		{"level", "7", true},
+		{"  speed  ", "2.5", true},
+		{"legendary", "true", true},
+		{"Missing", "", false},
+	}
+	for _, tt := range tests {
+		attr, ok := m.Attribute(tt.trait)
+		if ok != tt.ok {
+			t.Errorf("Attribute(%q) found = %v, want %v", tt.trait, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got, _ := attr.StringValue(); got != tt.want {
+			t.Errorf("Attribute(%q).StringValue() = %q, want %q", tt.trait, got, tt.want)
+		}
+	}
+
+	attrs := m.AttributeMap()
+	if _, ok := attrs[""]; ok {
+		t.Error("AttributeMap() includes an attribute without a trait type")
+	}
+	if bg := attrs["Background"]; bg.Value != "Blue" {
+		t.Errorf("AttributeMap()[Background] = %v, want the first occurrence", bg.Value)
+	}
+	if _, ok := attrs["Level"]; !ok {
+		t.Error("AttributeMap() does not trim trait types")
+	}
+}
+
+func TestNFTAttributeValues(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		attr    NFTAttribute
+		str     string
+		strOK   bool
+		float   float64
+		floatOK bool
+		int     int64
+		intOK   bool
+		numeric bool
+		boost   bool
+	}{
+		{name: "string", attr: NFTAttribute{Value: "Blue"},
+			str: "Blue", strOK: true},
+		{name: "integer as string", attr: NFTAttribute{Value: " 7 "},
+			str: " 7 ", strOK: true, float: 7, floatOK: true, int: 7, intOK: true},
+		{name: "float as string", attr: NFTAttribute{Value: "2.5"},
+			str: "2.5", strOK: true, float: 2.5, floatOK: true},
+		{name: "integer", attr: NFTAttribute{Value: float64(3)},
+			str: "3", strOK: true, float: 3, floatOK: true, int: 3, intOK: true, numeric: true},
+		{name: "float", attr: NFTAttribute{Value: 2.5},
+			str: "2.5", strOK: true, float: 2.5, floatOK: true, numeric: true},
+		{name: "json number", attr: NFTAttribute{Value: json.Number("42")},
+			str: "42", strOK: true, float: 42, floatOK: true, int: 42, intOK: true, numeric: true},
+		{name: "display number as string", attr: NFTAttribute{Value: "12", DisplayType: str("number")},
+			str: "12", strOK: true, float: 12, floatOK: true, int: 12, intOK: true, numeric: true},
+		{name: "boost number", attr: NFTAttribute{Value: float64(10), DisplayType: str("boost_number")},
+			str: "10", strOK: true, float: 10, floatOK: true, int: 10, intOK: true, numeric: true, boost: true},
+		{name: "boost percentage with sign", attr: NFTAttribute{Value: "15%", DisplayType: str(" Boost_Percentage ")},
+			str: "15%", strOK: true, float: 15, floatOK: true, int: 15, intOK: true, numeric: true, boost: true},
+		{name: "percent sign without boost", attr: NFTAttribute{Value: "15%"},
+			str: "15%", strOK: true},
+		{name: "boolean", attr: NFTAttribute{Value: true},
+			str: "true", strOK: true},
+		{name: "nil value", attr: NFTAttribute{}},
+		{name: "nil value with display type", attr: NFTAttribute{DisplayType: str("number")},
+			numeric: true},
+		{name: "object value", attr: NFTAttribute{Value: map[string]interface{}{"a": 1.0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if s, ok := tt.attr.StringValue(); s != tt.str || ok != tt.strOK {
+				t.Errorf("StringValue() = %q, %v; want %q, %v", s, ok, tt.str, tt.strOK)
+			}
+			if f, ok := tt.attr.FloatValue(); f != tt.float || ok != tt.floatOK {
+				t.Errorf("FloatValue() = %v, %v; want %v, %v", f, ok, tt.float, tt.floatOK)
+			}
+			if n, ok := tt.attr.IntValue(); n != tt.int || ok != tt.intOK {
+				t.Errorf("IntValue() = %v, %v; want %v, %v", n, ok, tt.int, tt.intOK)
+			}
+			if got := tt.attr.IsNumeric(); got != tt.numeric {
+				t.Errorf("IsNumeric() = %v, want %v", got, tt.numeric)
+			}
+			if got := tt.attr.IsBoost(); got != tt.boost {
+				t.Errorf("IsBoost() = %v, want %v", got, tt.boost)
+			}
+		})
+	}
+}
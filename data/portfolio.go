@@ -0,0 +1,213 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+// WithPortfolioURL sets the Portfolio API base URL and returns the client.
+// An empty url restores DefaultPortfolioURL. It must be called before the
+// client is used concurrently.
+func (c *Client) WithPortfolioURL(url string) *Client {
+	if url == "" {
+		url = DefaultPortfolioURL
+	}
+	c.portfolioURL = strings.TrimSuffix(url, "/")
+	return c
+}
+
+// PortfolioURL returns the Portfolio API base URL, without the API key.
+func (c *Client) PortfolioURL() string {
+	return c.portfolioURL
+}
+
+// portfolioEndpoint builds the Portfolio API URL for the given path.
+func (c *Client) portfolioEndpoint(path string) string {
+	return c.portfolioURL + "/" + c.apiKey + "/" + path
+}
+
+// portfolioNFTsResult wraps the Portfolio NFTs-by-address response.
+type portfolioNFTsResult struct {
+	Data PortfolioNFTsResponse `json:"data"`
+}
+
+// GetPortfolioNFTs retrieves the NFTs owned by one or more addresses across
+// multiple networks with a single Portfolio API call. Each NFT is tagged with
+// the network and address it was found on.
+// Use GetPortfolioNFTsIterator to page through all results.
+func (c *Client) GetPortfolioNFTs(ctx context.Context, params *PortfolioNFTsParams) (*PortfolioNFTsResponse, error) {
+	if params == nil {
+		return nil, fmt.Errorf("%w: params are required", sdkerrors.ErrInvalidParameter)
+	}
+	if err := validatePortfolioAddresses(params.Addresses, MaxPortfolioNFTAddresses, MaxPortfolioNFTNetworks); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.http.PostURL(ctx, c.portfolioEndpoint("assets/nfts/by-address"), params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result portfolioNFTsResult
+	if err := c.http.Unmarshal(respBody, &result); err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// validatePortfolioAddresses checks the address and network limits of a
+// Portfolio API request.
+func validatePortfolioAddresses(addresses []PortfolioAddress, maxAddresses, maxNetworks int) error {
+	if len(addresses) == 0 {
+		return fmt.Errorf("%w: at least one address is required", sdkerrors.ErrInvalidParameter)
+	}
+	if len(addresses) > maxAddresses {
+		return fmt.Errorf("%w: at most %d addresses are allowed, got %d", sdkerrors.ErrInvalidParameter, maxAddresses, len(addresses))
+	}
+	for _, a := range addresses {
+		if len(a.Networks) == 0 {
+			return fmt.Errorf("%w: no networks given for %s", sdkerrors.ErrInvalidParameter, a.Address)
+		}
+		if len(a.Networks) > maxNetworks {
+			return fmt.Errorf("%w: at most %d networks are allowed per address, got %d for %s", sdkerrors.ErrInvalidParameter, maxNetworks, len(a.Networks), a.Address)
+		}
+	}
+	return nil
+}
+
+// GetPortfolioNFTsIterator returns an iterator for paginating through the
+// NFTs owned by the given addresses across networks.
+// The params are copied; the caller's struct is not modified.
+func (c *Client) GetPortfolioNFTsIterator(ctx context.Context, params *PortfolioNFTsParams) *PortfolioNFTsIterator {
+	paramsCopy := *params
+	paramsCopy.Addresses = append([]PortfolioAddress(nil), params.Addresses...)
+	return &PortfolioNFTsIterator{
+		client: c,
+		params: &paramsCopy,
+		ctx:    ctx,
+	}
+}
+
+// PortfolioNFTsIterator iterates through multichain NFTs with pagination.
+type PortfolioNFTsIterator struct {
+	client  *Client
+	params  *PortfolioNFTsParams
+	ctx     context.Context
+	current *PortfolioNFTsResponse
+	index   int
+	done    bool
+	err     error
+	mu      sync.Mutex
+}
+
+// Next returns the next NFT in the iteration.
+// Returns nil when there are no more NFTs.
+func (it *PortfolioNFTsIterator) Next() (*PortfolioNFT, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	if it.done {
+		return nil, nil
+	}
+
+	if it.current == nil {
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return nil, err
+		}
+	}
+
+	if it.index < len(it.current.OwnedNFTs) {
+		nft := &it.current.OwnedNFTs[it.index]
+		it.index++
+		return nft, nil
+	}
+
+	if !it.current.HasMore() {
+		it.done = true
+		return nil, nil
+	}
+
+	it.params.PageKey = it.current.PageKey
+	if err := it.fetchNext(); err != nil {
+		it.err = err
+		return nil, err
+	}
+
+	if len(it.current.OwnedNFTs) == 0 {
+		it.done = true
+		return nil, nil
+	}
+
+	nft := &it.current.OwnedNFTs[0]
+	it.index = 1
+	return nft, nil
+}
+
+// HasNext returns true if there are more NFTs to iterate.
+func (it *PortfolioNFTsIterator) HasNext() bool {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.current != nil && it.index < len(it.current.OwnedNFTs) {
+		return true
+	}
+
+	if it.current != nil {
+		return it.current.HasMore()
+	}
+
+	return true
+}
+
+// Error returns any error encountered during iteration.
+func (it *PortfolioNFTsIterator) Error() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// Collect returns all remaining NFTs as a slice.
+func (it *PortfolioNFTsIterator) Collect() ([]PortfolioNFT, error) {
+	return it.CollectN(0)
+}
+
+// CollectN returns up to n NFTs. If n is zero or negative, all remaining NFTs are returned.
+func (it *PortfolioNFTsIterator) CollectN(n int) ([]PortfolioNFT, error) {
+	var nfts []PortfolioNFT
+
+	for n <= 0 || len(nfts) < n {
+		nft, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if nft == nil {
+			break
+		}
+		nfts = append(nfts, *nft)
+	}
+
+	return nfts, nil
+}
+
+func (it *PortfolioNFTsIterator) fetchNext() error {
+	result, err := it.client.GetPortfolioNFTs(it.ctx, it.params)
+	if err != nil {
+		return err
+	}
+	it.current = result
+	it.index = 0
+	return nil
+}
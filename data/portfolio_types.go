@@ -0,0 +1,138 @@
+package data
+
+import (
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// DefaultPortfolioURL is the Portfolio (multichain Data) API base URL.
+// Like the Prices API, it is served from a single host for all networks.
+const DefaultPortfolioURL = "https://api.g.alchemy.com/data/v1"
+
+// Portfolio API request limits.
+const (
+	// MaxPortfolioNFTAddresses is the maximum number of addresses per NFTs-by-address request.
+	MaxPortfolioNFTAddresses = 2
+	// MaxPortfolioNFTNetworks is the maximum number of networks per address.
+	MaxPortfolioNFTNetworks = 15
+)
+
+// PortfolioAddress is an address and the networks to query it on.
+// Networks are Alchemy network identifiers, e.g. "eth-mainnet" or
+// alchemy.BaseMainnet.String().
+type PortfolioAddress struct {
+	// Address is the wallet address.
+	Address types.Address `json:"address"`
+	// Networks are the networks to query.
+	Networks []string `json:"networks"`
+}
+
+// PortfolioNFTsParams represents the parameters for the Portfolio
+// NFTs-by-address endpoint.
+type PortfolioNFTsParams struct {
+	// Addresses are the addresses and networks to query (max 2 addresses, 15 networks each).
+	Addresses []PortfolioAddress `json:"addresses"`
+	// WithMetadata includes NFT metadata in the response.
+	WithMetadata *bool `json:"withMetadata,omitempty"`
+	// ExcludeFilters excludes NFTs matching these filters.
+	ExcludeFilters []NFTFilter `json:"excludeFilters,omitempty"`
+	// IncludeFilters includes only NFTs matching these filters.
+	IncludeFilters []NFTFilter `json:"includeFilters,omitempty"`
+	// SpamConfidenceLevel sets the spam detection threshold.
+	SpamConfidenceLevel SpamConfidenceLevel `json:"spamConfidenceLevel,omitempty"`
+	// OrderBy specifies the ordering of results.
+	OrderBy NFTOrderBy `json:"orderBy,omitempty"`
+	// PageKey is the pagination key.
+	PageKey string `json:"pageKey,omitempty"`
+	// PageSize is the number of results per page.
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// NewPortfolioNFTsParams creates new PortfolioNFTsParams.
+func NewPortfolioNFTsParams(addresses ...PortfolioAddress) *PortfolioNFTsParams {
+	return &PortfolioNFTsParams{
+		Addresses: addresses,
+	}
+}
+
+// AddAddress adds an address to query on the given networks.
+func (p *PortfolioNFTsParams) AddAddress(address types.Address, networks ...string) *PortfolioNFTsParams {
+	p.Addresses = append(p.Addresses, PortfolioAddress{Address: address, Networks: networks})
+	return p
+}
+
+// SetWithMetadata enables metadata in the response.
+func (p *PortfolioNFTsParams) SetWithMetadata(withMetadata bool) *PortfolioNFTsParams {
+	p.WithMetadata = &withMetadata
+	return p
+}
+
+// SetExcludeFilters sets exclusion filters.
+func (p *PortfolioNFTsParams) SetExcludeFilters(filters []NFTFilter) *PortfolioNFTsParams {
+	p.ExcludeFilters = filters
+	return p
+}
+
+// SetIncludeFilters sets inclusion filters.
+func (p *PortfolioNFTsParams) SetIncludeFilters(filters []NFTFilter) *PortfolioNFTsParams {
+	p.IncludeFilters = filters
+	return p
+}
+
+// SetSpamConfidenceLevel sets the spam detection threshold.
+func (p *PortfolioNFTsParams) SetSpamConfidenceLevel(level SpamConfidenceLevel) *PortfolioNFTsParams {
+	p.SpamConfidenceLevel = level
+	return p
+}
+
+// SetOrderBy sets the ordering.
+func (p *PortfolioNFTsParams) SetOrderBy(orderBy NFTOrderBy) *PortfolioNFTsParams {
+	p.OrderBy = orderBy
+	return p
+}
+
+// SetPageKey sets the pagination key.
+func (p *PortfolioNFTsParams) SetPageKey(pageKey string) *PortfolioNFTsParams {
+	p.PageKey = pageKey
+	return p
+}
+
+// SetPageSize sets the page size.
+func (p *PortfolioNFTsParams) SetPageSize(size int) *PortfolioNFTsParams {
+	p.PageSize = &size
+	return p
+}
+
+// PortfolioNFT is an NFT returned by the Portfolio API, tagged with the
+// network and address it was found on.
+type PortfolioNFT struct {
+	OwnedNFT
+	// Network is the network the NFT is on.
+	Network string `json:"network"`
+	// Address is the queried owner address.
+	Address types.Address `json:"address"`
+}
+
+// PortfolioNFTsResponse represents the response from the Portfolio
+// NFTs-by-address endpoint.
+type PortfolioNFTsResponse struct {
+	// OwnedNFTs is the list of NFTs across all queried networks.
+	OwnedNFTs []PortfolioNFT `json:"ownedNfts"`
+	// TotalCount is the total number of NFTs.
+	TotalCount int `json:"totalCount"`
+	// PageKey is the pagination key for fetching more results.
+	PageKey string `json:"pageKey,omitempty"`
+}
+
+// HasMore returns true if there are more results available.
+func (r *PortfolioNFTsResponse) HasMore() bool {
+	return r.PageKey != ""
+}
+
+// ByNetwork groups the NFTs by network.
+func (r *PortfolioNFTsResponse) ByNetwork() map[string][]PortfolioNFT {
+	groups := make(map[string][]PortfolioNFT)
+	for _, nft := range r.OwnedNFTs {
+		groups[nft.Network] = append(groups[nft.Network], nft)
+	}
+	return groups
+}
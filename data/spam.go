@@ -0,0 +1,190 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// GetSpamContracts returns the full list of contracts Alchemy classifies as
+// spam on the client's network.
+func (c *Client) GetSpamContracts(ctx context.Context) ([]types.Address, error) {
+	var result struct {
+		ContractAddresses []types.Address `json:"contractAddresses"`
+	}
+	if err := c.nftGet(ctx, "getSpamContracts", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.ContractAddresses, nil
+}
+
+// SpamContractSet is an in-memory set of spam contract addresses loaded from
+// getSpamContracts. It allows spam checks without one API call per lookup.
+// It is safe for concurrent use.
+type SpamContractSet struct {
+	client *Client
+
+	mu        sync.RWMutex
+	contracts map[types.Address]struct{}
+	updatedAt time.Time
+	lastErr   error
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewSpamContractSet loads the spam contract list once and returns a set
+// backed by it. Call StartRefresh to keep the set up to date.
+func NewSpamContractSet(ctx context.Context, client *Client) (*SpamContractSet, error) {
+	s := &SpamContractSet{
+		client: client,
+		stop:   make(chan struct{}),
+	}
+	if err := s.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Contains returns true if the address is in the spam set.
+func (s *SpamContractSet) Contains(address types.Address) bool {
+	key := types.Address(strings.ToLower(address.String()))
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.contracts[key]
+	return ok
+}
+
+// Len returns the number of contracts in the set.
+func (s *SpamContractSet) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.contracts)
+}
+
+// UpdatedAt returns the time of the last successful load.
+func (s *SpamContractSet) UpdatedAt() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.updatedAt
+}
+
+// LastError returns the error from the most recent refresh, or nil if it succeeded.
+func (s *SpamContractSet) LastError() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastErr
+}
+
+// Refresh reloads the spam contract list. On failure the previous set is kept.
+func (s *SpamContractSet) Refresh(ctx context.Context) error {
+	addresses, err := s.client.GetSpamContracts(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastErr = err
+	if err != nil {
+		return err
+	}
+
+	contracts := make(map[types.Address]struct{}, len(addresses))
+	for _, addr := range addresses {
+		contracts[types.Address(strings.ToLower(addr.String()))] = struct{}{}
+	}
+	s.contracts = contracts
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// StartRefresh starts a background goroutine that reloads the set every
+// interval until Stop is called or ctx is cancelled. Refresh errors are
+// recorded and available through LastError. Calling StartRefresh more than
+// once has no effect.
+func (s *SpamContractSet) StartRefresh(ctx context.Context, interval time.Duration) {
+	s.mu.Lock()
+	if s.done != nil || interval <= 0 {
+		s.mu.Unlock()
+		return
+	}
+	s.done = make(chan struct{})
+	s.mu.Unlock()
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				_ = s.Refresh(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops the background refresh goroutine, if any, and waits for it to exit.
+func (s *SpamContractSet) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+
+	s.mu.RLock()
+	done := s.done
+	s.mu.RUnlock()
+	if done != nil {
+		<-done
+	}
+}
+
+// ReportSpamContract reports a contract to Alchemy as spam.
+// Rate-limit responses are retried by the HTTP client and surface as an
+// errors.HTTPError recognised by errors.IsRateLimitError.
+func (c *Client) ReportSpamContract(ctx context.Context, contractAddress types.Address) error {
+	addr, err := types.ParseAddress(contractAddress.String())
+	if err != nil {
+		return fmt.Errorf("%w: %s", sdkerrors.ErrInvalidAddress, contractAddress)
+	}
+
+	query := url.Values{}
+	query.Set("address", addr.String())
+
+	// A successful report may have an empty body, so the response is not decoded.
+	_, err = c.nftGetRaw(ctx, "reportSpam", query)
+	return err
+}
+
+// SpamReportResult holds the outcome of reporting a single contract.
+type SpamReportResult struct {
+	// ContractAddress is the reported contract.
+	ContractAddress types.Address
+	// Error is the failure for this contract, or nil on success.
+	Error error
+}
+
+// ReportSpamContracts reports each contract as spam and returns one result
+// per input address in the same order. A failure for one address does not
+// stop the remaining reports; only context cancellation aborts the batch.
+func (c *Client) ReportSpamContracts(ctx context.Context, contractAddresses []types.Address) ([]SpamReportResult, error) {
+	results := make([]SpamReportResult, len(contractAddresses))
+	for i, addr := range contractAddresses {
+		if err := ctx.Err(); err != nil {
+			return results[:i], err
+		}
+		results[i] = SpamReportResult{
+			ContractAddress: addr,
+			Error:           c.ReportSpamContract(ctx, addr),
+		}
+	}
+	return results, nil
+}
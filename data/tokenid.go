@@ -0,0 +1,131 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// TokenID is an NFT token ID.
+// The NFT endpoints return token IDs in decimal while transfers and webhooks
+// use hex; TokenID normalizes both so IDs from different sources can be
+// compared and joined. Use Decimal() as a map key.
+type TokenID struct {
+	value *big.Int
+}
+
+// ParseTokenID parses a token ID in decimal ("1234") or 0x-prefixed hex ("0x4d2") form.
+// Token IDs are uint256 values; IDs above 2^256-1 are rejected.
+func ParseTokenID(s string) (TokenID, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return TokenID{}, fmt.Errorf("invalid token ID: empty string")
+	}
+	n, err := parseBigInt(s)
+	if err != nil {
+		return TokenID{}, fmt.Errorf("invalid token ID: %s", s)
+	}
+	if n.Sign() < 0 {
+		return TokenID{}, fmt.Errorf("invalid token ID: %s is negative", s)
+	}
+	if n.BitLen() > 256 {
+		return TokenID{}, fmt.Errorf("invalid token ID: %s exceeds 256 bits", s)
+	}
+	return TokenID{value: n}, nil
+}
+
+// MustParseTokenID is like ParseTokenID but panics on error.
+func MustParseTokenID(s string) TokenID {
+	id, err := ParseTokenID(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NewTokenID creates a TokenID from a *big.Int.
+func NewTokenID(n *big.Int) TokenID {
+	if n == nil {
+		return TokenID{}
+	}
+	return TokenID{value: new(big.Int).Set(n)}
+}
+
+// BigInt returns a copy of the token ID as a *big.Int.
+func (t TokenID) BigInt() *big.Int {
+	if t.value == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(t.value)
+}
+
+// Decimal returns the token ID in decimal form.
+func (t TokenID) Decimal() string {
+	return t.BigInt().String()
+}
+
+// Hex returns the token ID as minimal 0x-prefixed hex.
+func (t TokenID) Hex() string {
+	return hex.EncodeBigInt(t.BigInt())
+}
+
+// PaddedHex returns the token ID as 0x-prefixed hex left-padded to 32 bytes,
+// as it appears in indexed event topics.
+func (t TokenID) PaddedHex() string {
+	return fmt.Sprintf("0x%064x", t.BigInt())
+}
+
+// Topic returns the token ID as a 32-byte topic for log filtering.
+func (t TokenID) Topic() types.Hash {
+	return types.Hash(t.PaddedHex())
+}
+
+// Equal returns true if both token IDs have the same value.
+func (t TokenID) Equal(other TokenID) bool {
+	return t.BigInt().Cmp(other.BigInt()) == 0
+}
+
+// String returns the token ID in decimal form.
+func (t TokenID) String() string {
+	return t.Decimal()
+}
+
+// MarshalJSON implements json.Marshaler. The token ID is encoded as a decimal string.
+func (t TokenID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Decimal())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts a decimal or hex string, or a JSON number.
+func (t *TokenID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var n json.Number
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("invalid token ID: %s", string(data))
+		}
+		s = n.String()
+	}
+	id, err := ParseTokenID(s)
+	if err != nil {
+		return err
+	}
+	*t = id
+	return nil
+}
+
+// optionalTokenID parses an optional token ID string.
+func optionalTokenID(s *string) (TokenID, bool) {
+	if s == nil {
+		return TokenID{}, false
+	}
+	id, err := ParseTokenID(*s)
+	if err != nil {
+		return TokenID{}, false
+	}
+	return id, true
+}
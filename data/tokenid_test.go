@@ -0,0 +1,43 @@
+package data
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTokenID(t *testing.T) {
+	maxUint256 := "0x" + strings.Repeat("f", 64)
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "1234", want: "1234"},
+		{in: "0x4d2", want: "1234"},
+		{in: " 0x4D2 ", want: "1234"},
+		{in: maxUint256, want: "115792089237316195423570985008687907853269984665640564039457584007913129639935"},
+		{in: "0x1" + strings.Repeat("0", 64), wantErr: true},
+		{in: "115792089237316195423570985008687907853269984665640564039457584007913129639936", wantErr: true},
+		{in: "-1", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			id, err := ParseTokenID(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseTokenID() = %s, want error", id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTokenID() error = %v", err)
+			}
+			if got := id.Decimal(); got != tt.want {
+				t.Errorf("ParseTokenID() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,72 +2,268 @@ package data
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
+	"sync"
 
 	"github.com/ABT-Tech-Limited/alchemy-go/types"
 )
 
 // GetTokenBalances retrieves token balances for an address.
+// It returns an error wrapping errors.ErrInvalidParameter if params fail Validate.
 func (c *Client) GetTokenBalances(ctx context.Context, params *TokenBalancesParams) (*TokenBalancesResponse, error) {
-	// Build the request parameters
-	reqParams := make([]interface{}, 0, 3)
-	reqParams = append(reqParams, params.Address.String())
-
-	// Add contract addresses or token spec
-	if len(params.ContractAddresses) > 0 {
-		addrs := make([]string, len(params.ContractAddresses))
-		for i, addr := range params.ContractAddresses {
-			addrs[i] = addr.String()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result TokenBalancesResponse
+	if err := c.rpc.Call(ctx, "alchemy_getTokenBalances", params.rpcParams(), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTokenBalancesIterator returns an iterator for paginating through token balances.
+// The params are copied; the caller's struct is not modified.
+func (c *Client) GetTokenBalancesIterator(ctx context.Context, params *TokenBalancesParams) *TokenBalancesIterator {
+	paramsCopy := *params
+	paramsCopy.ContractAddresses = append([]types.Address(nil), params.ContractAddresses...)
+	return &TokenBalancesIterator{
+		client: c,
+		params: &paramsCopy,
+		ctx:    ctx,
+	}
+}
+
+// TokenBalancesIterator iterates through token balances with pagination.
+type TokenBalancesIterator struct {
+	client  *Client
+	params  *TokenBalancesParams
+	ctx     context.Context
+	current *TokenBalancesResponse
+	index   int
+	done    bool
+	err     error
+	mu      sync.Mutex
+}
+
+// Next returns the next token balance in the iteration.
+// Returns nil when there are no more balances.
+func (it *TokenBalancesIterator) Next() (*TokenBalance, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	if it.done {
+		return nil, nil
+	}
+
+	if it.current == nil {
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return nil, err
 		}
-		reqParams = append(reqParams, addrs)
-	} else if params.TokenSpec != "" {
-		reqParams = append(reqParams, string(params.TokenSpec))
 	}
 
-	// Add options if needed
-	if params.PageKey != "" || params.MaxCount > 0 {
-		options := make(map[string]interface{})
-		if params.PageKey != "" {
-			options["pageKey"] = params.PageKey
+	if it.index < len(it.current.TokenBalances) {
+		balance := &it.current.TokenBalances[it.index]
+		it.index++
+		return balance, nil
+	}
+
+	if !it.current.HasMore() {
+		it.done = true
+		return nil, nil
+	}
+
+	it.params.PageKey = it.current.PageKey
+	if err := it.fetchNext(); err != nil {
+		it.err = err
+		return nil, err
+	}
+
+	if len(it.current.TokenBalances) == 0 {
+		it.done = true
+		return nil, nil
+	}
+
+	balance := &it.current.TokenBalances[0]
+	it.index = 1
+	return balance, nil
+}
+
+// HasNext returns true if there are more balances to iterate.
+func (it *TokenBalancesIterator) HasNext() bool {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.current != nil && it.index < len(it.current.TokenBalances) {
+		return true
+	}
+
+	if it.current != nil {
+		return it.current.HasMore()
+	}
+
+	return true
+}
+
+// Error returns any error encountered during iteration.
+func (it *TokenBalancesIterator) Error() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// Collect returns all remaining balances as a slice.
+func (it *TokenBalancesIterator) Collect() ([]TokenBalance, error) {
+	return it.CollectN(0)
+}
+
+// CollectN returns up to n balances. If n is zero or negative, all remaining balances are returned.
+func (it *TokenBalancesIterator) CollectN(n int) ([]TokenBalance, error) {
+	var balances []TokenBalance
+
+	for n <= 0 || len(balances) < n {
+		balance, err := it.Next()
+		if err != nil {
+			return nil, err
 		}
-		if params.MaxCount > 0 {
-			options["maxCount"] = params.MaxCount
+		if balance == nil {
+			break
 		}
-		reqParams = append(reqParams, options)
+		balances = append(balances, *balance)
 	}
 
-	var result TokenBalancesResponse
-	if err := c.rpc.Call(ctx, "alchemy_getTokenBalances", reqParams, &result); err != nil {
-		return nil, err
+	return balances, nil
+}
+
+func (it *TokenBalancesIterator) fetchNext() error {
+	result, err := it.client.GetTokenBalances(it.ctx, it.params)
+	if err != nil {
+		return err
 	}
-	return &result, nil
+	it.current = result
+	it.index = 0
+	return nil
+}
+
+// DefaultTokenBalancesConcurrency is the default number of concurrent
+// requests made by GetTokenBalancesForAddressesWithOptions.
+const DefaultTokenBalancesConcurrency = 5
+
+// TokenBalancesForAddressesOptions configures GetTokenBalancesForAddressesWithOptions.
+type TokenBalancesForAddressesOptions struct {
+	// ContractAddresses restricts the balances to these tokens (optional).
+	ContractAddresses []types.Address
+	// Concurrency is the maximum number of requests in flight
+	// (default: DefaultTokenBalancesConcurrency).
+	Concurrency int
+}
+
+// AddressTokenBalances is the token balance result for one address.
+type AddressTokenBalances struct {
+	// Address is the queried address.
+	Address types.Address
+	// Balances is the response, or nil if Error is set.
+	Balances *TokenBalancesResponse
+	// Error is the error returned for this address, if any.
+	Error error
 }
 
 // GetTokenBalancesForAddresses retrieves token balances for multiple addresses.
-// This is a convenience method that makes multiple calls.
+// It is a wrapper around GetTokenBalancesForAddressesWithOptions with the
+// default concurrency that fails if any address fails.
 func (c *Client) GetTokenBalancesForAddresses(ctx context.Context, addresses []types.Address, contractAddresses []types.Address) (map[types.Address]*TokenBalancesResponse, error) {
-	results := make(map[types.Address]*TokenBalancesResponse)
+	balances, err := c.GetTokenBalancesForAddressesWithOptions(ctx, addresses, &TokenBalancesForAddressesOptions{
+		ContractAddresses: contractAddresses,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	for _, addr := range addresses {
-		params := NewTokenBalancesParams(addr)
-		if len(contractAddresses) > 0 {
-			params.SetContractAddresses(contractAddresses)
+	results := make(map[types.Address]*TokenBalancesResponse, len(balances))
+	for _, b := range balances {
+		if b.Error != nil {
+			return nil, fmt.Errorf("failed to get token balances for %s: %w", b.Address, b.Error)
 		}
+		results[b.Address] = b.Balances
+	}
+	return results, nil
+}
 
-		result, err := c.GetTokenBalances(ctx, params)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get token balances for %s: %w", addr, err)
+// GetTokenBalancesForAddressesWithOptions retrieves token balances for
+// multiple addresses, running up to opts.Concurrency requests at a time.
+// Results are returned in address order; a failure for one address is
+// reported in its Error field and does not stop the scan.
+// If ctx is canceled, no further requests are started, addresses that were
+// not queried carry the context error, and the partial results are returned
+// together with ctx.Err().
+func (c *Client) GetTokenBalancesForAddressesWithOptions(ctx context.Context, addresses []types.Address, opts *TokenBalancesForAddressesOptions) ([]AddressTokenBalances, error) {
+	if opts == nil {
+		opts = &TokenBalancesForAddressesOptions{}
+	}
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = DefaultTokenBalancesConcurrency
+	}
+
+	results := make([]AddressTokenBalances, len(addresses))
+	for i, addr := range addresses {
+		results[i].Address = addr
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, addr := range addresses {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			for j := i; j < len(addresses); j++ {
+				results[j].Error = ctx.Err()
+			}
+			return results, ctx.Err()
+		case sem <- struct{}{}:
 		}
-		results[addr] = result
+
+		wg.Add(1)
+		go func(i int, addr types.Address) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			params := NewTokenBalancesParams(addr)
+			if len(opts.ContractAddresses) > 0 {
+				params.SetContractAddresses(opts.ContractAddresses)
+			}
+			results[i].Balances, results[i].Error = c.GetTokenBalances(ctx, params)
+		}(i, addr)
 	}
+	wg.Wait()
 
-	return results, nil
+	return results, ctx.Err()
 }
 
 // GetTokenMetadata retrieves metadata for a token.
+// Results are served from the metadata cache when it is enabled
+// (see WithTokenMetadataCache).
 func (c *Client) GetTokenMetadata(ctx context.Context, contractAddress types.Address) (*TokenMetadata, error) {
+	if c.metadataCache != nil {
+		return c.metadataCache.get(ctx, c.tokenMetadataKey(contractAddress), func(ctx context.Context) (*TokenMetadata, error) {
+			return c.fetchTokenMetadata(ctx, contractAddress)
+		})
+	}
+	return c.fetchTokenMetadata(ctx, contractAddress)
+}
+
+// fetchTokenMetadata calls alchemy_getTokenMetadata.
+func (c *Client) fetchTokenMetadata(ctx context.Context, contractAddress types.Address) (*TokenMetadata, error) {
 	var result TokenMetadata
 	if err := c.rpc.Call(ctx, "alchemy_getTokenMetadata", []interface{}{contractAddress.String()}, &result); err != nil {
 		return nil, err
@@ -75,14 +271,15 @@ func (c *Client) GetTokenMetadata(ctx context.Context, contractAddress types.Add
 	return &result, nil
 }
 
-// GetTokensForOwner retrieves all tokens owned by an address.
+// GetTokensForOwner retrieves a page of tokens owned by an address.
+// Use GetTokensForOwnerWithParams to filter by contract, or
+// GetTokensForOwnerIterator to page through all results.
 func (c *Client) GetTokensForOwner(ctx context.Context, owner types.Address, pageKey string) (*TokensForOwnerResponse, error) {
-	params := make(map[string]interface{})
-	params["owner"] = owner.String()
-	if pageKey != "" {
-		params["pageKey"] = pageKey
-	}
+	return c.GetTokensForOwnerWithParams(ctx, NewTokensForOwnerParams(owner).SetPageKey(pageKey))
+}
 
+// GetTokensForOwnerWithParams retrieves a page of tokens owned by an address.
+func (c *Client) GetTokensForOwnerWithParams(ctx context.Context, params *TokensForOwnerParams) (*TokensForOwnerResponse, error) {
 	var result TokensForOwnerResponse
 	if err := c.rpc.Call(ctx, "alchemy_getTokensForOwner", []interface{}{params}, &result); err != nil {
 		return nil, err
@@ -90,6 +287,141 @@ func (c *Client) GetTokensForOwner(ctx context.Context, owner types.Address, pag
 	return &result, nil
 }
 
+// GetTokensForOwnerIterator returns an iterator for paginating through the tokens owned by an address.
+func (c *Client) GetTokensForOwnerIterator(ctx context.Context, params *TokensForOwnerParams) *TokensForOwnerIterator {
+	paramsCopy := *params
+	return &TokensForOwnerIterator{
+		client: c,
+		params: &paramsCopy,
+		ctx:    ctx,
+	}
+}
+
+// GetAllTokensForOwner retrieves all tokens owned by an address (handles pagination).
+func (c *Client) GetAllTokensForOwner(ctx context.Context, owner types.Address) ([]OwnedToken, error) {
+	return c.GetTokensForOwnerIterator(ctx, NewTokensForOwnerParams(owner)).Collect()
+}
+
+// TokensForOwnerIterator iterates through the tokens owned by an address with pagination.
+type TokensForOwnerIterator struct {
+	client  *Client
+	params  *TokensForOwnerParams
+	ctx     context.Context
+	current *TokensForOwnerResponse
+	index   int
+	done    bool
+	err     error
+	mu      sync.Mutex
+}
+
+// Next returns the next token in the iteration.
+// Returns nil when there are no more tokens.
+func (it *TokensForOwnerIterator) Next() (*OwnedToken, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	if it.done {
+		return nil, nil
+	}
+
+	if it.current == nil {
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return nil, err
+		}
+	}
+
+	if it.index < len(it.current.Tokens) {
+		token := &it.current.Tokens[it.index]
+		it.index++
+		return token, nil
+	}
+
+	if !it.current.HasMore() {
+		it.done = true
+		return nil, nil
+	}
+
+	it.params.PageKey = it.current.PageKey
+	if err := it.fetchNext(); err != nil {
+		it.err = err
+		return nil, err
+	}
+
+	if len(it.current.Tokens) == 0 {
+		it.done = true
+		return nil, nil
+	}
+
+	token := &it.current.Tokens[0]
+	it.index = 1
+	return token, nil
+}
+
+// HasNext returns true if there are more tokens to iterate.
+func (it *TokensForOwnerIterator) HasNext() bool {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.current != nil && it.index < len(it.current.Tokens) {
+		return true
+	}
+
+	if it.current != nil {
+		return it.current.HasMore()
+	}
+
+	return true
+}
+
+// Error returns any error encountered during iteration.
+func (it *TokensForOwnerIterator) Error() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// Collect returns all remaining tokens as a slice.
+func (it *TokensForOwnerIterator) Collect() ([]OwnedToken, error) {
+	return it.CollectN(0)
+}
+
+// CollectN returns up to n tokens. If n is zero or negative, all remaining tokens are returned.
+func (it *TokensForOwnerIterator) CollectN(n int) ([]OwnedToken, error) {
+	var tokens []OwnedToken
+
+	for n <= 0 || len(tokens) < n {
+		token, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			break
+		}
+		tokens = append(tokens, *token)
+	}
+
+	return tokens, nil
+}
+
+func (it *TokensForOwnerIterator) fetchNext() error {
+	result, err := it.client.GetTokensForOwnerWithParams(it.ctx, it.params)
+	if err != nil {
+		return err
+	}
+	it.current = result
+	it.index = 0
+	return nil
+}
+
 // GetTokenAllowance retrieves the allowance for a spender.
 func (c *Client) GetTokenAllowance(ctx context.Context, params *TokenAllowanceParams) (*TokenAllowanceResponse, error) {
 	reqParams := map[string]string{
@@ -107,16 +439,15 @@ func (c *Client) GetTokenAllowance(ctx context.Context, params *TokenAllowancePa
 
 // nftHTTPGet makes a GET request to the NFT API.
 func (c *Client) nftHTTPGet(ctx context.Context, path string, query url.Values, result interface{}) error {
-	// Build the URL
-	fullURL := c.nftURL + "/" + path
+	fullURL := c.nftEndpoint(path)
 	if len(query) > 0 {
 		fullURL = fullURL + "?" + query.Encode()
 	}
 
-	body, err := c.http.Get(ctx, fullURL)
+	body, err := c.http.GetURL(ctx, fullURL)
 	if err != nil {
 		return err
 	}
 
-	return json.Unmarshal(body, result)
+	return c.http.Unmarshal(body, result)
 }
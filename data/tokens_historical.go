@@ -0,0 +1,138 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/abi"
+	"github.com/ABT-Tech-Limited/alchemy-go/node"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// maxHistoricalBalanceBatchSize is the number of balanceOf calls per JSON-RPC batch.
+const maxHistoricalBalanceBatchSize = 100
+
+// selectorBalanceOf is the selector of balanceOf(address).
+var selectorBalanceOf = abi.Selector("0x70a08231")
+
+// ErrNoContractCode is returned when a balanceOf call returns no data,
+// which means the address has no contract code at that block.
+var ErrNoContractCode = errors.New("empty return data: address is not a contract at this block")
+
+// ErrBalanceOfReverted is returned when a balanceOf call reverts, which
+// usually means the contract is not an ERC20 token.
+var ErrBalanceOfReverted = errors.New("balanceOf reverted: contract is likely not an ERC20 token")
+
+// HistoricalTokenBalance is the balance of one token at a block.
+type HistoricalTokenBalance struct {
+	// ContractAddress is the token contract address.
+	ContractAddress types.Address
+	// Balance is the raw balance, or nil if Error is set.
+	Balance *big.Int
+	// Error is the error for this token, if any.
+	Error error
+}
+
+// GetTokenBalanceAt returns the ERC20 balance of holder at the given block by
+// calling balanceOf with eth_call. Unlike alchemy_getTokenBalances, which
+// only reflects the latest state, it works for any block the node has state
+// for (an archive node for old blocks).
+// It returns an error wrapping ErrNoContractCode if the token address has no
+// code at the block, and ErrBalanceOfReverted if the call reverts.
+func (c *Client) GetTokenBalanceAt(ctx context.Context, token, holder types.Address, block uint64) (*big.Int, error) {
+	to := token
+	result, err := node.NewClient(c.rpc).Call(ctx, balanceOfCall(&to, holder), node.BlockNumber(block))
+	if err != nil {
+		return nil, balanceOfError(token, block, err)
+	}
+	return decodeBalanceOf(token, block, result)
+}
+
+// GetTokenBalancesAt returns the ERC20 balances of holder for each token at
+// the given block, using JSON-RPC batches. Results are returned in token
+// order; per-token failures are reported in HistoricalTokenBalance.Error.
+func (c *Client) GetTokenBalancesAt(ctx context.Context, tokens []types.Address, holder types.Address, block uint64) ([]HistoricalTokenBalance, error) {
+	balances := make([]HistoricalTokenBalance, len(tokens))
+	blockTag := node.BlockNumber(block).String()
+
+	for start := 0; start < len(tokens); start += maxHistoricalBalanceBatchSize {
+		end := start + maxHistoricalBalanceBatchSize
+		if end > len(tokens) {
+			end = len(tokens)
+		}
+
+		results := make([]types.Data, end-start)
+		calls := make([]client.BatchCall, end-start)
+		for i := range calls {
+			to := tokens[start+i]
+			calls[i] = client.BatchCall{
+				Method: "eth_call",
+				Params: []interface{}{balanceOfCall(&to, holder), blockTag},
+				Result: &results[i],
+			}
+		}
+
+		batch, err := c.rpc.BatchCall(ctx, calls)
+		if err != nil {
+			return nil, err
+		}
+		for i := range calls {
+			token := tokens[start+i]
+			b := &balances[start+i]
+			b.ContractAddress = token
+			if batch[i].Error != nil {
+				b.Error = balanceOfError(token, block, batch[i].Error)
+				continue
+			}
+			b.Balance, b.Error = decodeBalanceOf(token, block, results[i].Bytes())
+		}
+	}
+
+	return balances, nil
+}
+
+// balanceOfCall returns the eth_call message for balanceOf(holder).
+func balanceOfCall(token *types.Address, holder types.Address) *node.CallMsg {
+	return &node.CallMsg{
+		To:   token,
+		Data: abi.EncodeCall(selectorBalanceOf, abi.Address(holder.Bytes())),
+	}
+}
+
+// decodeBalanceOf decodes the return data of balanceOf.
+func decodeBalanceOf(token types.Address, block uint64, result []byte) (*big.Int, error) {
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%w (token %s, block %d)", ErrNoContractCode, token, block)
+	}
+	balance, err := abi.DecodeUint256(result, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%w: balanceOf on %s returned %d bytes", sdkerrors.ErrInvalidResponse, token, len(result))
+	}
+	return balance, nil
+}
+
+// balanceOfError describes a failed balanceOf call, distinguishing reverts
+// from other errors.
+func balanceOfError(token types.Address, block uint64, err error) error {
+	var rpcErr *sdkerrors.JSONRPCError
+	if !sdkerrors.As(err, &rpcErr) || !isRevertError(rpcErr) {
+		return fmt.Errorf("balanceOf on %s at block %d failed: %w", token, block, err)
+	}
+	if reason, ok := sdkerrors.DecodeRevertReason(rpcErr); ok {
+		return fmt.Errorf("%w (token %s, block %d): %s", ErrBalanceOfReverted, token, block, reason)
+	}
+	return fmt.Errorf("%w (token %s, block %d)", ErrBalanceOfReverted, token, block)
+}
+
+// isRevertError reports whether a JSON-RPC error is an execution revert.
+func isRevertError(e *sdkerrors.JSONRPCError) bool {
+	if _, ok := e.RevertData(); ok {
+		return true
+	}
+	return e.Code == 3 || strings.Contains(strings.ToLower(e.Message), "revert")
+}
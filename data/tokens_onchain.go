@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/abi"
+	"github.com/ABT-Tech-Limited/alchemy-go/node"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// Function selectors for on-chain ERC20 metadata.
+var (
+	selectorName   = abi.Selector("0x06fdde03") // name()
+	selectorSymbol = abi.Selector("0x95d89b41") // symbol()
+)
+
+// GetTokenMetadataOnChain reads a token's name, symbol and decimals directly
+// from the contract with eth_call, in a single JSON-RPC batch. It is useful
+// for long-tail tokens the metadata API has no data for.
+// Fields the contract does not implement are left nil; both string and
+// bytes32 name/symbol return values are supported.
+func (c *Client) GetTokenMetadataOnChain(ctx context.Context, contractAddress types.Address) (*TokenMetadata, error) {
+	to := contractAddress
+	selectors := [][]byte{selectorName, selectorSymbol, selectorDecimals}
+	results := make([]types.Data, len(selectors))
+	calls := make([]client.BatchCall, len(selectors))
+	for i, selector := range selectors {
+		calls[i] = client.BatchCall{
+			Method: "eth_call",
+			Params: []interface{}{&node.CallMsg{To: &to, Data: selector}, node.BlockLatest.String()},
+			Result: &results[i],
+		}
+	}
+
+	batch, err := c.rpc.BatchCall(ctx, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata TokenMetadata
+	if batch[0].Error == nil {
+		if name, err := abi.DecodeString(results[0].Bytes()); err == nil && name != "" {
+			metadata.Name = &name
+		}
+	}
+	if batch[1].Error == nil {
+		if symbol, err := abi.DecodeString(results[1].Bytes()); err == nil && symbol != "" {
+			metadata.Symbol = &symbol
+		}
+	}
+	if batch[2].Error == nil {
+		if n, err := abi.DecodeUint256(results[2].Bytes(), 0); err == nil && n.IsInt64() && n.Int64() <= 255 {
+			decimals := int(n.Int64())
+			metadata.Decimals = &decimals
+		}
+	}
+	return &metadata, nil
+}
+
+// fillTokenMetadata sets the nil fields of metadata from fallback.
+func fillTokenMetadata(metadata, fallback *TokenMetadata) {
+	if metadata.Name == nil {
+		metadata.Name = fallback.Name
+	}
+	if metadata.Symbol == nil {
+		metadata.Symbol = fallback.Symbol
+	}
+	if metadata.Decimals == nil {
+		metadata.Decimals = fallback.Decimals
+	}
+}
+
+// GetTokenMetadataWithFallback retrieves token metadata from the API and
+// reads any missing name, symbol or decimals on-chain.
+// If the API call fails, the on-chain metadata is returned on its own.
+func (c *Client) GetTokenMetadataWithFallback(ctx context.Context, contractAddress types.Address) (*TokenMetadata, error) {
+	metadata, err := c.GetTokenMetadata(ctx, contractAddress)
+	if err == nil && metadata.Name != nil && metadata.Symbol != nil && metadata.Decimals != nil {
+		return metadata, nil
+	}
+
+	onChain, chainErr := c.GetTokenMetadataOnChain(ctx, contractAddress)
+	if err != nil {
+		if chainErr != nil {
+			return nil, err
+		}
+		return onChain, nil
+	}
+	if chainErr != nil {
+		return metadata, nil
+	}
+
+	// Copy so that a cached response is not modified.
+	filled := *metadata
+	fillTokenMetadata(&filled, onChain)
+	return &filled, nil
+}
@@ -1,6 +1,12 @@
 package data
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
 	"github.com/ABT-Tech-Limited/alchemy-go/types"
 )
 
@@ -52,6 +58,60 @@ func (p *TokenBalancesParams) SetMaxCount(count int) *TokenBalancesParams {
 	return p
 }
 
+// SetPageKey sets the pagination key returned by a previous call.
+func (p *TokenBalancesParams) SetPageKey(pageKey string) *TokenBalancesParams {
+	p.PageKey = pageKey
+	return p
+}
+
+// Validate checks that the parameters form a valid request. ContractAddresses
+// and TokenSpec are mutually exclusive: the API takes one or the other as the
+// same positional parameter. The returned error wraps errors.ErrInvalidParameter.
+func (p *TokenBalancesParams) Validate() error {
+	if len(p.ContractAddresses) > 0 && p.TokenSpec != "" {
+		return fmt.Errorf("%w: contract addresses and token spec %q cannot both be set", sdkerrors.ErrInvalidParameter, p.TokenSpec)
+	}
+	if p.MaxCount < 0 {
+		return fmt.Errorf("%w: maxCount must not be negative", sdkerrors.ErrInvalidParameter)
+	}
+	return nil
+}
+
+// rpcParams returns the positional parameters for alchemy_getTokenBalances:
+// the address, then the contract list or token spec, then the page options.
+// Page options are only accepted after a token spec, so ERC20 is assumed when
+// paginating without one.
+func (p *TokenBalancesParams) rpcParams() []interface{} {
+	reqParams := make([]interface{}, 0, 3)
+	reqParams = append(reqParams, p.Address.String())
+
+	hasOptions := p.PageKey != "" || p.MaxCount > 0
+	switch {
+	case len(p.ContractAddresses) > 0:
+		addrs := make([]string, len(p.ContractAddresses))
+		for i, addr := range p.ContractAddresses {
+			addrs[i] = addr.String()
+		}
+		reqParams = append(reqParams, addrs)
+	case p.TokenSpec != "":
+		reqParams = append(reqParams, string(p.TokenSpec))
+	case hasOptions:
+		reqParams = append(reqParams, string(TokenSpecERC20))
+	}
+
+	if hasOptions {
+		options := make(map[string]interface{})
+		if p.PageKey != "" {
+			options["pageKey"] = p.PageKey
+		}
+		if p.MaxCount > 0 {
+			options["maxCount"] = p.MaxCount
+		}
+		reqParams = append(reqParams, options)
+	}
+	return reqParams
+}
+
 // TokenBalancesResponse represents the response from getTokenBalances.
 type TokenBalancesResponse struct {
 	// Address is the queried address.
@@ -94,6 +154,35 @@ type TokenMetadata struct {
 	Logo *string `json:"logo,omitempty"`
 }
 
+// TokensForOwnerParams represents the parameters for getTokensForOwner.
+type TokensForOwnerParams struct {
+	// Owner is the wallet address to query.
+	Owner types.Address `json:"owner"`
+	// ContractAddresses restricts the results to these tokens (optional).
+	ContractAddresses []types.Address `json:"contractAddresses,omitempty"`
+	// PageKey is the pagination key for fetching more results.
+	PageKey string `json:"pageKey,omitempty"`
+}
+
+// NewTokensForOwnerParams creates a new TokensForOwnerParams.
+func NewTokensForOwnerParams(owner types.Address) *TokensForOwnerParams {
+	return &TokensForOwnerParams{
+		Owner: owner,
+	}
+}
+
+// SetContractAddresses restricts the results to specific token contracts.
+func (p *TokensForOwnerParams) SetContractAddresses(addresses []types.Address) *TokensForOwnerParams {
+	p.ContractAddresses = addresses
+	return p
+}
+
+// SetPageKey sets the pagination key.
+func (p *TokensForOwnerParams) SetPageKey(pageKey string) *TokensForOwnerParams {
+	p.PageKey = pageKey
+	return p
+}
+
 // TokensForOwnerResponse represents the response from getTokensForOwner.
 type TokensForOwnerResponse struct {
 	// Tokens is the list of tokens owned by the address.
@@ -111,7 +200,7 @@ func (r *TokensForOwnerResponse) HasMore() bool {
 type OwnedToken struct {
 	// ContractAddress is the token contract address.
 	ContractAddress types.Address `json:"contractAddress"`
-	// RawBalance is the raw balance (hex encoded).
+	// RawBalance is the raw balance in the smallest unit.
 	RawBalance string `json:"rawBalance"`
 	// Balance is the formatted balance.
 	Balance string `json:"balance"`
@@ -127,6 +216,30 @@ type OwnedToken struct {
 	Error *string `json:"error,omitempty"`
 }
 
+// BalanceBigInt returns the raw balance in the smallest unit.
+// RawBalance is accepted in both hex (0x-prefixed) and decimal form.
+func (t *OwnedToken) BalanceBigInt() (*big.Int, error) {
+	return parseBigInt(t.RawBalance)
+}
+
+// Amount returns the balance scaled by the token decimals.
+// Returns false if the decimals are unknown or the raw balance is invalid.
+func (t *OwnedToken) Amount() (*big.Float, bool) {
+	if t.Decimals == nil || *t.Decimals < 0 {
+		return nil, false
+	}
+
+	raw, err := t.BalanceBigInt()
+	if err != nil {
+		return nil, false
+	}
+
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(*t.Decimals)), nil)
+	amount := new(big.Float).SetInt(raw)
+	amount.Quo(amount, new(big.Float).SetInt(divisor))
+	return amount, true
+}
+
 // TokenAllowanceParams represents the parameters for getTokenAllowance.
 type TokenAllowanceParams struct {
 	// Contract is the token contract address.
@@ -139,6 +252,45 @@ type TokenAllowanceParams struct {
 
 // TokenAllowanceResponse represents the response from getTokenAllowance.
 type TokenAllowanceResponse struct {
-	// Allowance is the allowance amount (hex encoded).
+	// Allowance is the allowance amount (hex or decimal encoded).
 	Allowance string `json:"allowance"`
 }
+
+// UnmarshalJSON accepts both the bare string result returned by
+// alchemy_getTokenAllowance and the {"allowance": ...} object form.
+func (r *TokenAllowanceResponse) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		r.Allowance = s
+		return nil
+	}
+	var obj struct {
+		Allowance string `json:"allowance"`
+	}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return err
+	}
+	r.Allowance = obj.Allowance
+	return nil
+}
+
+// Value returns the allowance as a big.Int.
+func (r *TokenAllowanceResponse) Value() (*big.Int, error) {
+	return parseBigInt(r.Allowance)
+}
+
+// parseBigInt parses an integer string in either hex (0x-prefixed) or decimal form.
+// An empty string is treated as zero.
+func parseBigInt(s string) (*big.Int, error) {
+	if s == "" {
+		return big.NewInt(0), nil
+	}
+	if hex.Has0xPrefix(s) {
+		return hex.DecodeBigInt(s)
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer: %s", s)
+	}
+	return n, nil
+}
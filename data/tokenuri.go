@@ -0,0 +1,191 @@
+package data
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/abi"
+	"github.com/ABT-Tech-Limited/alchemy-go/node"
+)
+
+// Limits applied by FetchTokenURIMetadata.
+const (
+	// TokenURIFetchTimeout bounds the HTTP fetch of the metadata document.
+	TokenURIFetchTimeout = 10 * time.Second
+	// MaxTokenURIMetadataSize is the maximum metadata document size in bytes.
+	MaxTokenURIMetadataSize = 1 << 20
+	// tokenURISnippetSize is the number of payload bytes kept in TokenURIMetadataError.
+	tokenURISnippetSize = 128
+)
+
+// Function selectors for on-chain token URI lookups.
+var (
+	selectorTokenURI = abi.Selector("0xc87b56dd") // tokenURI(uint256)
+	selectorURI      = abi.Selector("0x0e89341c") // uri(uint256)
+)
+
+// tokenURIHTTPClient fetches metadata documents from arbitrary hosts.
+var tokenURIHTTPClient = &http.Client{}
+
+// TokenURIMetadataError is returned when a metadata document cannot be decoded.
+type TokenURIMetadataError struct {
+	// URI is the URI the document was fetched from.
+	URI string
+	// Snippet contains the first bytes of the payload.
+	Snippet string
+	// Err is the underlying decode error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *TokenURIMetadataError) Error() string {
+	return fmt.Sprintf("malformed token metadata from %s: %v (payload: %q)", e.URI, e.Err, e.Snippet)
+}
+
+// Unwrap returns the underlying error.
+func (e *TokenURIMetadataError) Unwrap() error {
+	return e.Err
+}
+
+// FetchTokenURIMetadata fetches an NFT's metadata directly from its token URI,
+// bypassing Alchemy's metadata cache. If the NFT carries no token URI, it is
+// read on-chain with tokenURI (ERC721) or uri (ERC1155).
+// ipfs://, ar:// and data: URIs are supported; HTTP fetches are bounded by
+// TokenURIFetchTimeout and MaxTokenURIMetadataSize.
+func (c *Client) FetchTokenURIMetadata(ctx context.Context, nft *OwnedNFT) (*NFTRawMetadata, error) {
+	if nft == nil {
+		return nil, fmt.Errorf("%w: nft is required", sdkerrors.ErrInvalidParameter)
+	}
+
+	uri, err := c.tokenURI(ctx, nft)
+	if err != nil {
+		return nil, err
+	}
+	if uri == "" {
+		return nil, fmt.Errorf("%w: token has no URI", sdkerrors.ErrInvalidResponse)
+	}
+
+	var payload []byte
+	if strings.HasPrefix(strings.ToLower(uri), "data:") {
+		payload, err = decodeDataURI(uri)
+	} else {
+		payload, err = fetchTokenURI(ctx, c.ResolveURI(uri))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata NFTRawMetadata
+	if err := json.Unmarshal(payload, &metadata); err != nil {
+		snippet := payload
+		if len(snippet) > tokenURISnippetSize {
+			snippet = snippet[:tokenURISnippetSize]
+		}
+		return nil, &TokenURIMetadataError{URI: uri, Snippet: string(snippet), Err: err}
+	}
+	return &metadata, nil
+}
+
+// tokenURI returns the NFT's token URI, reading it on-chain if necessary.
+func (c *Client) tokenURI(ctx context.Context, nft *OwnedNFT) (string, error) {
+	if nft.TokenURI != nil && *nft.TokenURI != "" {
+		return *nft.TokenURI, nil
+	}
+	if nft.Raw != nil && nft.Raw.TokenURI != nil && *nft.Raw.TokenURI != "" {
+		return *nft.Raw.TokenURI, nil
+	}
+
+	tokenID, err := ParseTokenID(nft.TokenID)
+	if err != nil {
+		return "", err
+	}
+
+	isERC1155 := strings.EqualFold(nft.TokenType, "ERC1155")
+	selector := selectorTokenURI
+	if isERC1155 {
+		selector = selectorURI
+	}
+
+	contract := nft.Contract.Address
+	result, err := node.NewClient(c.rpc).Call(ctx, &node.CallMsg{
+		To:   &contract,
+		Data: abi.EncodeCall(selector, abi.Uint256(tokenID.BigInt())),
+	}, node.BlockLatest)
+	if err != nil {
+		return "", err
+	}
+
+	uri, err := abi.DecodeString(result)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", sdkerrors.ErrInvalidResponse, err)
+	}
+
+	// ERC1155 substitutes {id} with the lowercase, zero-padded hex token ID.
+	if isERC1155 {
+		uri = strings.ReplaceAll(uri, "{id}", strings.TrimPrefix(tokenID.PaddedHex(), "0x"))
+	}
+	return uri, nil
+}
+
+// decodeDataURI returns the payload of a data: URI.
+func decodeDataURI(uri string) ([]byte, error) {
+	comma := strings.IndexByte(uri, ',')
+	if comma < 0 {
+		return nil, fmt.Errorf("%w: malformed data URI", sdkerrors.ErrInvalidResponse)
+	}
+	header, body := uri[len("data:"):comma], uri[comma+1:]
+
+	if strings.HasSuffix(strings.ToLower(header), ";base64") {
+		b, err := base64.StdEncoding.DecodeString(body)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid base64 data URI: %v", sdkerrors.ErrInvalidResponse, err)
+		}
+		return b, nil
+	}
+
+	s, err := url.PathUnescape(body)
+	if err != nil {
+		// Inline JSON frequently contains unescaped '%'; use it verbatim.
+		return []byte(body), nil
+	}
+	return []byte(s), nil
+}
+
+// fetchTokenURI fetches a metadata document over HTTP.
+func fetchTokenURI(ctx context.Context, rawURL string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, TokenURIFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid token URI %q: %v", sdkerrors.ErrInvalidParameter, rawURL, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := tokenURIHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch token URI: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, sdkerrors.NewHTTPError(resp.StatusCode, resp.Status, nil)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxTokenURIMetadataSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token URI response: %w", err)
+	}
+	if len(body) > MaxTokenURIMetadataSize {
+		return nil, fmt.Errorf("%w: token metadata exceeds %d bytes", sdkerrors.ErrInvalidResponse, MaxTokenURIMetadataSize)
+	}
+	return body, nil
+}
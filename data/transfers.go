@@ -2,15 +2,21 @@ package data
 
 import (
 	"context"
+	"math/big"
 	"sync"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
 )
 
 // GetAssetTransfers retrieves asset transfers matching the given parameters.
+// If an AddressLabeler is set, the transfers' address labels are filled in.
 func (c *Client) GetAssetTransfers(ctx context.Context, params *AssetTransfersParams) (*AssetTransfersResponse, error) {
 	var result AssetTransfersResponse
 	if err := c.rpc.Call(ctx, "alchemy_getAssetTransfers", []interface{}{params}, &result); err != nil {
 		return nil, err
 	}
+	c.LabelTransfers(ctx, result.Transfers)
 	return &result, nil
 }
 
@@ -32,9 +38,29 @@ type AssetTransfersIterator struct {
 	ctx     context.Context
 	current *AssetTransfersResponse
 	index   int
+	pages   int
 	done    bool
 	err     error
 	mu      sync.Mutex
+
+	// dedup state only remembers IDs from the current and previous page
+	// to keep memory flat on large scans.
+	dedup   bool
+	prevIDs map[string]struct{}
+	curIDs  map[string]struct{}
+
+	// collect is set while CollectAll runs.
+	collect *collectState
+}
+
+// SetDeduplicate enables or disables skipping transfers whose UniqueID was
+// already returned on the current or previous page. This guards against
+// duplicates when paging with SortDesc near the chain head.
+func (it *AssetTransfersIterator) SetDeduplicate(enabled bool) *AssetTransfersIterator {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	it.dedup = enabled
+	return it
 }
 
 // Next returns the next transfer in the iteration.
@@ -43,6 +69,21 @@ func (it *AssetTransfersIterator) Next() (*AssetTransfer, error) {
 	it.mu.Lock()
 	defer it.mu.Unlock()
 
+	for {
+		transfer, err := it.next()
+		if err != nil || transfer == nil {
+			return transfer, err
+		}
+		if it.dedup && it.markSeen(transfer.UniqueID) {
+			continue
+		}
+		it.collect.count()
+		return transfer, nil
+	}
+}
+
+// next returns the next transfer without deduplication. The caller must hold it.mu.
+func (it *AssetTransfersIterator) next() (*AssetTransfer, error) {
 	if it.err != nil {
 		return nil, it.err
 	}
@@ -73,6 +114,9 @@ func (it *AssetTransfersIterator) Next() (*AssetTransfer, error) {
 	}
 
 	// Fetch next page
+	if !it.collect.allowFetch(it.pages) {
+		return nil, nil
+	}
 	it.params.PageKey = it.current.PageKey
 	if err := it.fetchNext(); err != nil {
 		it.err = err
@@ -126,8 +170,11 @@ func (it *AssetTransfersIterator) Reset() {
 
 	it.current = nil
 	it.index = 0
+	it.pages = 0
 	it.done = false
 	it.err = nil
+	it.prevIDs = nil
+	it.curIDs = nil
 	it.params.PageKey = ""
 }
 
@@ -168,12 +215,133 @@ func (it *AssetTransfersIterator) CollectN(n int) ([]AssetTransfer, error) {
 	return transfers, nil
 }
 
+// CollectAll returns the remaining transfers, stopping at the limits in opts.
+// Page requests made by the call use ctx. The returned flag is true if a
+// limit was reached while more transfers were available. On error, the
+// transfers gathered so far are returned with it.
+func (it *AssetTransfersIterator) CollectAll(ctx context.Context, opts *CollectOptions) ([]AssetTransfer, bool, error) {
+	o := opts.withDefaults()
+	state := newCollectState(ctx, o)
+	it.mu.Lock()
+	it.collect = state
+	it.mu.Unlock()
+	defer func() {
+		it.mu.Lock()
+		it.collect = nil
+		it.mu.Unlock()
+	}()
+
+	var transfers []AssetTransfer
+	for {
+		if err := ctx.Err(); err != nil {
+			return transfers, false, client.ContextError(ctx, err)
+		}
+
+		if len(transfers) >= o.MaxItems {
+			return transfers, it.HasNext(), nil
+		}
+
+		transfer, err := it.Next()
+		if o.OnPage != nil {
+			state.drain(&it.mu, o.OnPage)
+		}
+		if err != nil {
+			return transfers, false, err
+		}
+		if transfer == nil {
+			break
+		}
+		transfers = append(transfers, *transfer)
+	}
+
+	it.mu.Lock()
+	state.finish(it.pages)
+	limited := state.limited
+	it.mu.Unlock()
+	if o.OnPage != nil {
+		state.drain(&it.mu, o.OnPage)
+	}
+	return transfers, limited && it.HasNext(), nil
+}
+
 func (it *AssetTransfersIterator) fetchNext() error {
-	result, err := it.client.GetAssetTransfers(it.ctx, it.params)
+	result, err := it.client.GetAssetTransfers(it.collect.context(it.ctx), it.params)
 	if err != nil {
 		return err
 	}
 	it.current = result
 	it.index = 0
+	it.pages++
+	it.collect.fetched(it.pages)
+	if it.dedup {
+		it.prevIDs = it.curIDs
+		it.curIDs = make(map[string]struct{}, len(result.Transfers))
+	}
 	return nil
 }
+
+// markSeen records id and returns true if it was already seen on the current
+// or previous page. Empty IDs are never treated as duplicates.
+func (it *AssetTransfersIterator) markSeen(id string) bool {
+	if id == "" {
+		return false
+	}
+	if _, ok := it.prevIDs[id]; ok {
+		return true
+	}
+	if _, ok := it.curIDs[id]; ok {
+		return true
+	}
+	if it.curIDs == nil {
+		it.curIDs = make(map[string]struct{})
+	}
+	it.curIDs[id] = struct{}{}
+	return false
+}
+
+// GroupTransfersByTx groups transfers by their transaction hash.
+// A single transaction (e.g. a swap) commonly produces several transfers.
+func GroupTransfersByTx(transfers []AssetTransfer) map[types.Hash][]AssetTransfer {
+	groups := make(map[types.Hash][]AssetTransfer)
+	for _, t := range transfers {
+		groups[t.Hash] = append(groups[t.Hash], t)
+	}
+	return groups
+}
+
+// SumTransferValues iterates all transfers matching params and returns the
+// total value moved per asset symbol, using each transfer's ExactValue.
+// Transfers without a symbol or value (e.g. most NFT transfers) are skipped,
+// and transfers repeated across pages are counted once.
+// Different tokens may share a symbol; restrict params.ContractAddresses to
+// the tokens of interest when totals must be unambiguous.
+func (c *Client) SumTransferValues(ctx context.Context, params *AssetTransfersParams) (map[string]*big.Float, error) {
+	it := c.GetAssetTransfersIterator(ctx, params).SetDeduplicate(true)
+
+	totals := make(map[string]*big.Float)
+	for {
+		transfer, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if transfer == nil {
+			break
+		}
+		if transfer.Asset == nil || *transfer.Asset == "" {
+			continue
+		}
+		value, ok := transfer.ExactValue()
+		if !ok {
+			continue
+		}
+
+		total, ok := totals[*transfer.Asset]
+		if !ok {
+			total = new(big.Float).SetPrec(transferValuePrec)
+			totals[*transfer.Asset] = total
+		}
+		total.Add(total, value)
+	}
+
+	return totals, nil
+}
@@ -1,6 +1,12 @@
 package data
 
 import (
+	"fmt"
+	"math/big"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+	"github.com/ABT-Tech-Limited/alchemy-go/node"
 	"github.com/ABT-Tech-Limited/alchemy-go/types"
 )
 
@@ -17,6 +23,46 @@ const (
 	CategorySpecialNFT AssetTransferCategory = "specialnft"
 )
 
+// MaxAssetTransfersCount is the maximum value accepted for AssetTransfersParams.MaxCount.
+const MaxAssetTransfersCount = 1000
+
+// restrictedTransferCategories lists categories that are only supported on
+// some networks, keyed by category and then by network identifier.
+// Categories not listed here are supported on every network.
+var restrictedTransferCategories = map[AssetTransferCategory]map[string]struct{}{
+	CategoryInternal: {
+		"eth-mainnet":     {},
+		"eth-sepolia":     {},
+		"polygon-mainnet": {},
+		"polygon-amoy":    {},
+	},
+	CategorySpecialNFT: {
+		"eth-mainnet": {},
+	},
+}
+
+// IsSupportedOn returns true if the category is supported on the given
+// network identifier (e.g. "eth-mainnet").
+func (c AssetTransferCategory) IsSupportedOn(network string) bool {
+	networks, restricted := restrictedTransferCategories[c]
+	if !restricted {
+		return true
+	}
+	_, ok := networks[network]
+	return ok
+}
+
+// isKnown returns true if the category is one of the defined categories.
+func (c AssetTransferCategory) isKnown() bool {
+	switch c {
+	case CategoryExternal, CategoryInternal, CategoryERC20,
+		CategoryERC721, CategoryERC1155, CategorySpecialNFT:
+		return true
+	default:
+		return false
+	}
+}
+
 // SortOrder represents the sort order for results.
 type SortOrder string
 
@@ -72,6 +118,37 @@ func (p *AssetTransfersParams) SetToBlock(block string) *AssetTransfersParams {
 	return p
 }
 
+// SetFromBlockNumber sets the starting block from a block number.
+func (p *AssetTransfersParams) SetFromBlockNumber(block uint64) *AssetTransfersParams {
+	p.FromBlock = hex.EncodeUint64(block)
+	return p
+}
+
+// SetToBlockNumber sets the ending block from a block number.
+func (p *AssetTransfersParams) SetToBlockNumber(block uint64) *AssetTransfersParams {
+	p.ToBlock = hex.EncodeUint64(block)
+	return p
+}
+
+// SetBlockRange sets both the starting and ending block from block numbers.
+func (p *AssetTransfersParams) SetBlockRange(from, to uint64) *AssetTransfersParams {
+	p.FromBlock = hex.EncodeUint64(from)
+	p.ToBlock = hex.EncodeUint64(to)
+	return p
+}
+
+// SetFromBlockTag sets the starting block from a block number or tag (e.g. node.BlockLatest).
+func (p *AssetTransfersParams) SetFromBlockTag(block node.BlockNumberOrTag) *AssetTransfersParams {
+	p.FromBlock = block.String()
+	return p
+}
+
+// SetToBlockTag sets the ending block from a block number or tag (e.g. node.BlockLatest).
+func (p *AssetTransfersParams) SetToBlockTag(block node.BlockNumberOrTag) *AssetTransfersParams {
+	p.ToBlock = block.String()
+	return p
+}
+
 // SetFromAddress sets the from address filter.
 func (p *AssetTransfersParams) SetFromAddress(address types.Address) *AssetTransfersParams {
 	p.FromAddress = &address
@@ -110,10 +187,46 @@ func (p *AssetTransfersParams) SetWithMetadata(withMetadata bool) *AssetTransfer
 
 // SetMaxCount sets the maximum number of results.
 func (p *AssetTransfersParams) SetMaxCount(count int) *AssetTransfersParams {
-	p.MaxCount = "0x" + string(rune(count))
+	p.MaxCount = hex.EncodeUint64(uint64(count))
 	return p
 }
 
+// Validate checks the parameters against what the API accepts on the given
+// network identifier (e.g. alchemy.EthMainnet.String()). The returned error
+// wraps errors.ErrInvalidParameter.
+func (p *AssetTransfersParams) Validate(network string) error {
+	if len(p.Category) == 0 {
+		return fmt.Errorf("%w: at least one transfer category is required", sdkerrors.ErrInvalidParameter)
+	}
+
+	for _, category := range p.Category {
+		if !category.isKnown() {
+			return fmt.Errorf("%w: unknown transfer category %q", sdkerrors.ErrInvalidParameter, category)
+		}
+		if !category.IsSupportedOn(network) {
+			return fmt.Errorf("%w: transfer category %q is not supported on %s", sdkerrors.ErrInvalidParameter, category, network)
+		}
+	}
+
+	switch p.Order {
+	case "", SortAsc, SortDesc:
+	default:
+		return fmt.Errorf("%w: invalid sort order %q", sdkerrors.ErrInvalidParameter, p.Order)
+	}
+
+	if p.MaxCount != "" {
+		count, err := hex.DecodeUint64(p.MaxCount)
+		if err != nil || !hex.Has0xPrefix(p.MaxCount) {
+			return fmt.Errorf("%w: maxCount must be a hex string, got %q", sdkerrors.ErrInvalidParameter, p.MaxCount)
+		}
+		if count > MaxAssetTransfersCount {
+			return fmt.Errorf("%w: maxCount %s exceeds the maximum of 0x3e8", sdkerrors.ErrInvalidParameter, p.MaxCount)
+		}
+	}
+
+	return nil
+}
+
 // AssetTransfersResponse represents the response from getAssetTransfers.
 type AssetTransfersResponse struct {
 	// PageKey is the pagination key for fetching more results.
@@ -153,6 +266,39 @@ type AssetTransfer struct {
 	RawContract RawContract `json:"rawContract"`
 	// Metadata contains additional metadata (when WithMetadata is true).
 	Metadata *TransferMetadata `json:"metadata,omitempty"`
+	// FromLabel is the label of the sender, if an AddressLabeler is set.
+	FromLabel string `json:"fromLabel,omitempty"`
+	// ToLabel is the label of the recipient, if an AddressLabeler is set.
+	ToLabel string `json:"toLabel,omitempty"`
+}
+
+// TokenIDValue returns the NFT token ID of the transfer.
+// Returns false if the transfer has no single token ID (see ERC1155Metadata).
+func (t *AssetTransfer) TokenIDValue() (TokenID, bool) {
+	return optionalTokenID(t.TokenID)
+}
+
+// transferValuePrec is the big.Float precision used for exact transfer values.
+const transferValuePrec = 256
+
+// ExactValue returns the transferred value scaled by the token decimals,
+// computed from the raw contract value so it is not subject to float64
+// rounding. If the raw value or decimals are missing it falls back to Value.
+// Returns false if the transfer carries no value (e.g. NFT transfers).
+func (t *AssetTransfer) ExactValue() (*big.Float, bool) {
+	if t.RawContract.Value != nil && t.RawContract.Decimal != nil {
+		raw, err := parseBigInt(*t.RawContract.Value)
+		decimals, derr := parseBigInt(*t.RawContract.Decimal)
+		if err == nil && derr == nil && decimals.IsInt64() && decimals.Int64() <= 255 {
+			v := new(big.Float).SetPrec(transferValuePrec).SetInt(raw)
+			scale := new(big.Float).SetPrec(transferValuePrec).SetInt(new(big.Int).Exp(big.NewInt(10), decimals, nil))
+			return v.Quo(v, scale), true
+		}
+	}
+	if t.Value != nil {
+		return new(big.Float).SetPrec(transferValuePrec).SetFloat64(*t.Value), true
+	}
+	return nil, false
 }
 
 // BlockNumber returns the block number as uint64.
@@ -198,6 +344,11 @@ type ERC1155Metadata struct {
 	Value string `json:"value"`
 }
 
+// TokenIDValue returns the parsed token ID.
+func (m *ERC1155Metadata) TokenIDValue() (TokenID, bool) {
+	return optionalTokenID(&m.TokenID)
+}
+
 // TransferMetadata contains additional transfer metadata.
 type TransferMetadata struct {
 	// BlockTimestamp is the block timestamp in ISO format.
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"strings"
+)
+
+// Default gateways used by ResolveURI.
+const (
+	DefaultIPFSGateway    = "https://ipfs.io"
+	DefaultArweaveGateway = "https://arweave.net"
+)
+
+// GatewayOptions configures the gateways ResolveURI rewrites to.
+type GatewayOptions struct {
+	// IPFSGateway is the gateway for ipfs:// and ipns:// URIs (default: DefaultIPFSGateway).
+	IPFSGateway string
+	// ArweaveGateway is the gateway for ar:// URIs (default: DefaultArweaveGateway).
+	ArweaveGateway string
+}
+
+// withDefaults returns a copy of the options with default values applied.
+func (o *GatewayOptions) withDefaults() GatewayOptions {
+	var opts GatewayOptions
+	if o != nil {
+		opts = *o
+	}
+	if opts.IPFSGateway == "" {
+		opts.IPFSGateway = DefaultIPFSGateway
+	}
+	if opts.ArweaveGateway == "" {
+		opts.ArweaveGateway = DefaultArweaveGateway
+	}
+	opts.IPFSGateway = strings.TrimSuffix(opts.IPFSGateway, "/")
+	opts.ArweaveGateway = strings.TrimSuffix(opts.ArweaveGateway, "/")
+	return opts
+}
+
+// ResolveURI rewrites decentralized storage URIs to fetchable HTTP URLs.
+//
+//   - ipfs://CID/path, ipfs://ipfs/CID/path, ipfs/CID and /ipfs/CID become <gateway>/ipfs/CID/path
+//   - ipns://name/path becomes <gateway>/ipns/name/path
+//   - ar://txid becomes <arweave gateway>/txid
+//   - http(s) and data: URIs, and anything unrecognized, are returned unchanged
+//
+// A nil opts uses the default gateways.
+func ResolveURI(uri string, opts *GatewayOptions) string {
+	o := opts.withDefaults()
+	trimmed := strings.TrimSpace(uri)
+	lower := strings.ToLower(trimmed)
+
+	switch {
+	case strings.HasPrefix(lower, "ipfs://"):
+		path := trimmed[len("ipfs://"):]
+		if strings.HasPrefix(strings.ToLower(path), "ipfs/") {
+			path = path[len("ipfs/"):]
+		}
+		return o.IPFSGateway + "/ipfs/" + path
+	case strings.HasPrefix(lower, "ipns://"):
+		return o.IPFSGateway + "/ipns/" + trimmed[len("ipns://"):]
+	case strings.HasPrefix(lower, "ar://"):
+		return o.ArweaveGateway + "/" + trimmed[len("ar://"):]
+	case strings.HasPrefix(lower, "ipfs/"), strings.HasPrefix(lower, "ipns/"):
+		return o.IPFSGateway + "/" + trimmed
+	case strings.HasPrefix(lower, "/ipfs/"), strings.HasPrefix(lower, "/ipns/"):
+		return o.IPFSGateway + trimmed
+	default:
+		return uri
+	}
+}
+
+// SetGatewayOptions sets the gateways used by the client's ResolveURI.
+// It must be called before the client is used concurrently.
+func (c *Client) SetGatewayOptions(opts *GatewayOptions) {
+	c.gateways = opts
+}
+
+// ResolveURI rewrites a URI using the client's gateway options.
+// See the package-level ResolveURI.
+func (c *Client) ResolveURI(uri string) string {
+	return ResolveURI(uri, c.gateways)
+}
+
+// ResolvedDisplayURL returns BestDisplayURL resolved through the given gateways.
+func (i *NFTImage) ResolvedDisplayURL(opts *GatewayOptions) (string, bool) {
+	u, ok := i.BestDisplayURL()
+	if !ok {
+		return "", false
+	}
+	return ResolveURI(u, opts), true
+}
+
+// ResolvedOriginalURL returns OriginalURL resolved through the given gateways.
+func (i *NFTImage) ResolvedOriginalURL(opts *GatewayOptions) (string, bool) {
+	u, ok := firstURL(i.OriginalURL)
+	if !ok {
+		return "", false
+	}
+	return ResolveURI(u, opts), true
+}
+
+// ResolvedTokenURI returns TokenURI resolved through the given gateways.
+func (r *NFTRaw) ResolvedTokenURI(opts *GatewayOptions) (string, bool) {
+	u, ok := firstURL(r.TokenURI)
+	if !ok {
+		return "", false
+	}
+	return ResolveURI(u, opts), true
+}
+
+// ResolvedImage returns the metadata image URL resolved through the given gateways.
+func (r *NFTRaw) ResolvedImage(opts *GatewayOptions) (string, bool) {
+	if r.Metadata == nil {
+		return "", false
+	}
+	u, ok := firstURL(r.Metadata.Image)
+	if !ok {
+		return "", false
+	}
+	return ResolveURI(u, opts), true
+}
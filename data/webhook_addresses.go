@@ -0,0 +1,384 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// DefaultWebhookAddressChunkSize is the default number of addresses sent per
+// address update request.
+const DefaultWebhookAddressChunkSize = 1000
+
+// WithAddressChunkSize sets the maximum number of addresses sent per
+// ReplaceWebhookAddresses or UpdateWebhookAddresses request. Non-positive
+// values use DefaultWebhookAddressChunkSize.
+func WithAddressChunkSize(size int) WebhookClientOption {
+	return func(c *WebhookClient) {
+		c.addressChunkSize = size
+	}
+}
+
+// WebhookAddressesError reports a partially applied address update.
+// Chunks before Chunk were applied; the addresses in UnappliedAdds and
+// UnappliedRemoves (including those of the failed chunk) were not.
+type WebhookAddressesError struct {
+	// WebhookID is the ID of the webhook.
+	WebhookID string
+	// Chunk is the zero-based index of the chunk that failed.
+	Chunk int
+	// Chunks is the total number of chunks.
+	Chunks int
+	// Applied is the number of addresses applied before the failure.
+	Applied int
+	// UnappliedAdds are the addresses that were not added.
+	UnappliedAdds []string
+	// UnappliedRemoves are the addresses that were not removed.
+	UnappliedRemoves []string
+	// Err is the error returned for the failed chunk.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *WebhookAddressesError) Error() string {
+	return fmt.Sprintf("webhook %s: address update chunk %d/%d failed after %d addresses applied (%d adds, %d removes unapplied): %v",
+		e.WebhookID, e.Chunk+1, e.Chunks, e.Applied, len(e.UnappliedAdds), len(e.UnappliedRemoves), e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *WebhookAddressesError) Unwrap() error {
+	return e.Err
+}
+
+// normalizeWebhookAddresses validates addresses and returns them lowercased
+// with duplicates removed, preserving first-occurrence order. The error
+// names the first invalid entry.
+func normalizeWebhookAddresses(addresses []string) ([]string, error) {
+	out := make([]string, 0, len(addresses))
+	seen := make(map[string]struct{}, len(addresses))
+	for i, a := range addresses {
+		addr, err := types.ParseAddress(a)
+		if err != nil {
+			return nil, fmt.Errorf("%w: entry %d: %q", sdkerrors.ErrInvalidAddress, i, a)
+		}
+		key := addr.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, key)
+	}
+	return out, nil
+}
+
+// DiffWebhookAddresses computes the minimal changes that turn current into
+// desired. Addresses are compared case-insensitively and returned lowercased,
+// without duplicates, in input order.
+func DiffWebhookAddresses(current, desired []string) (toAdd, toRemove []string) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, addr := range current {
+		currentSet[strings.ToLower(addr)] = struct{}{}
+	}
+
+	desiredSet := make(map[string]struct{}, len(desired))
+	toAdd = []string{}
+	for _, addr := range desired {
+		key := strings.ToLower(addr)
+		if _, ok := desiredSet[key]; ok {
+			continue
+		}
+		desiredSet[key] = struct{}{}
+		if _, ok := currentSet[key]; !ok {
+			toAdd = append(toAdd, key)
+		}
+	}
+
+	toRemove = []string{}
+	for _, addr := range current {
+		key := strings.ToLower(addr)
+		if _, ok := desiredSet[key]; ok {
+			continue
+		}
+		desiredSet[key] = struct{}{} // report each removal once
+		toRemove = append(toRemove, key)
+	}
+	return toAdd, toRemove
+}
+
+// chunkSize returns the configured address chunk size.
+func (c *WebhookClient) chunkSize() int {
+	if c.addressChunkSize > 0 {
+		return c.addressChunkSize
+	}
+	return DefaultWebhookAddressChunkSize
+}
+
+// addressChunk is a single address update request.
+type addressChunk struct {
+	method  string
+	add     []string
+	remove  []string
+	replace bool
+}
+
+// applyAddressChunks sends chunks sequentially, stopping at the first failure.
+func (c *WebhookClient) applyAddressChunks(ctx context.Context, webhookID string, chunks []addressChunk) error {
+	applied := 0
+	for i, chunk := range chunks {
+		var body interface{}
+		if chunk.replace {
+			body = &ReplaceWebhookAddressesParams{WebhookID: webhookID, Addresses: chunk.add}
+		} else {
+			body = &UpdateWebhookAddressesParams{WebhookID: webhookID, AddressesToAdd: chunk.add, AddressesToRemove: chunk.remove}
+		}
+
+		if err := c.do(ctx, chunk.method, "/update-webhook-addresses", body, nil); err != nil {
+			addrErr := &WebhookAddressesError{
+				WebhookID: webhookID,
+				Chunk:     i,
+				Chunks:    len(chunks),
+				Applied:   applied,
+				Err:       err,
+			}
+			for _, rest := range chunks[i:] {
+				addrErr.UnappliedAdds = append(addrErr.UnappliedAdds, rest.add...)
+				addrErr.UnappliedRemoves = append(addrErr.UnappliedRemoves, rest.remove...)
+			}
+			return addrErr
+		}
+		applied += len(chunk.add) + len(chunk.remove)
+	}
+	return nil
+}
+
+// splitAddresses splits addresses into chunks of at most size.
+func splitAddresses(addresses []string, size int) [][]string {
+	var chunks [][]string
+	for start := 0; start < len(addresses); start += size {
+		end := start + size
+		if end > len(addresses) {
+			end = len(addresses)
+		}
+		chunks = append(chunks, addresses[start:end])
+	}
+	return chunks
+}
+
+// replaceAddressChunks plans a replace: the first chunk replaces the tracked
+// set and the rest are added.
+func replaceAddressChunks(addresses []string, size int) []addressChunk {
+	parts := splitAddresses(addresses, size)
+	if len(parts) == 0 {
+		return []addressChunk{{method: http.MethodPut, add: []string{}, replace: true}}
+	}
+
+	chunks := make([]addressChunk, len(parts))
+	for i, part := range parts {
+		if i == 0 {
+			chunks[i] = addressChunk{method: http.MethodPut, add: part, replace: true}
+		} else {
+			chunks[i] = addressChunk{method: http.MethodPatch, add: part, remove: []string{}}
+		}
+	}
+	return chunks
+}
+
+// updateAddressChunks plans an update: additions are sent before removals
+// so that addresses moving between chunks are never untracked.
+func updateAddressChunks(add, remove []string, size int) []addressChunk {
+	var chunks []addressChunk
+	for _, part := range splitAddresses(add, size) {
+		chunks = append(chunks, addressChunk{method: http.MethodPatch, add: part, remove: []string{}})
+	}
+	for _, part := range splitAddresses(remove, size) {
+		chunks = append(chunks, addressChunk{method: http.MethodPatch, add: []string{}, remove: part})
+	}
+	return chunks
+}
+
+// DefaultWebhookAddressPageSize is the default page size used when listing
+// webhook addresses.
+const DefaultWebhookAddressPageSize = 1000
+
+// WebhookAddressesOptions configures GetAllWebhookAddressesWithOptions.
+type WebhookAddressesOptions struct {
+	// PageSize is the number of addresses per request
+	// (default DefaultWebhookAddressPageSize).
+	PageSize int
+	// MaxAddresses stops after this many addresses (0 means no limit).
+	MaxAddresses int
+}
+
+// GetAllWebhookAddressesWithOptions retrieves the addresses tracked by a
+// webhook. The result slice is pre-sized from the total count reported with
+// the first page. Pages are fetched in order because the API's cursors are
+// opaque; use GetWebhookAddressesIterator to process addresses without
+// buffering them all.
+func (c *WebhookClient) GetAllWebhookAddressesWithOptions(ctx context.Context, webhookID string, opts *WebhookAddressesOptions) ([]string, error) {
+	var o WebhookAddressesOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	it := c.GetWebhookAddressesIterator(ctx, webhookID, o.PageSize)
+	var addresses []string
+	for o.MaxAddresses <= 0 || len(addresses) < o.MaxAddresses {
+		addr, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if addr == "" {
+			break
+		}
+		if addresses == nil {
+			n := it.TotalCount()
+			if o.MaxAddresses > 0 && n > o.MaxAddresses {
+				n = o.MaxAddresses
+			}
+			addresses = make([]string, 0, n)
+		}
+		addresses = append(addresses, addr)
+	}
+
+	return addresses, nil
+}
+
+// GetWebhookAddressesIterator returns an iterator over the addresses tracked
+// by a webhook, fetching pageSize addresses per request
+// (DefaultWebhookAddressPageSize if pageSize is not positive).
+func (c *WebhookClient) GetWebhookAddressesIterator(ctx context.Context, webhookID string, pageSize int) *WebhookAddressesIterator {
+	if pageSize <= 0 {
+		pageSize = DefaultWebhookAddressPageSize
+	}
+	return &WebhookAddressesIterator{
+		client: c,
+		params: &GetWebhookAddressesParams{WebhookID: webhookID, Limit: pageSize},
+		ctx:    ctx,
+	}
+}
+
+// WebhookAddressesIterator iterates through webhook addresses with pagination.
+type WebhookAddressesIterator struct {
+	client  *WebhookClient
+	params  *GetWebhookAddressesParams
+	ctx     context.Context
+	current *GetWebhookAddressesResponse
+	index   int
+	done    bool
+	err     error
+	mu      sync.Mutex
+}
+
+// Next returns the next address in the iteration.
+// Returns "" when there are no more addresses.
+func (it *WebhookAddressesIterator) Next() (string, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.err != nil {
+		return "", it.err
+	}
+
+	for !it.done {
+		if it.current != nil && it.index < len(it.current.Data) {
+			addr := it.current.Data[it.index]
+			it.index++
+			return addr, nil
+		}
+
+		if it.current != nil {
+			if !it.current.HasMore() {
+				it.done = true
+				break
+			}
+			it.params.After = it.current.Pagination.Cursors.After
+		}
+
+		if err := it.fetchNext(); err != nil {
+			it.err = err
+			return "", err
+		}
+		if len(it.current.Data) == 0 {
+			it.done = true
+		}
+	}
+
+	return "", nil
+}
+
+// HasNext returns true if there are more addresses to iterate.
+func (it *WebhookAddressesIterator) HasNext() bool {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if it.current != nil && it.index < len(it.current.Data) {
+		return true
+	}
+
+	if it.current != nil {
+		return it.current.HasMore()
+	}
+
+	return true
+}
+
+// TotalCount returns the total number of addresses reported by the API.
+// It is zero until the first page has been fetched.
+func (it *WebhookAddressesIterator) TotalCount() int {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.current == nil {
+		return 0
+	}
+	return it.current.Pagination.TotalCount
+}
+
+// Error returns any error encountered during iteration.
+func (it *WebhookAddressesIterator) Error() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// Collect returns all remaining addresses as a slice.
+func (it *WebhookAddressesIterator) Collect() ([]string, error) {
+	return it.CollectN(0)
+}
+
+// CollectN returns up to n addresses. If n is zero or negative, all remaining addresses are returned.
+func (it *WebhookAddressesIterator) CollectN(n int) ([]string, error) {
+	var addresses []string
+
+	for n <= 0 || len(addresses) < n {
+		addr, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if addr == "" {
+			break
+		}
+		addresses = append(addresses, addr)
+	}
+
+	return addresses, nil
+}
+
+func (it *WebhookAddressesIterator) fetchNext() error {
+	result, err := it.client.GetWebhookAddresses(it.ctx, it.params)
+	if err != nil {
+		return err
+	}
+	it.current = result
+	it.index = 0
+	return nil
+}
@@ -0,0 +1,209 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// WebhookHandlers holds the callbacks invoked by a webhook handler.
+// Callbacks left nil are skipped and the event is acknowledged.
+// Returning an error from a callback responds with 500 so Alchemy retries delivery.
+type WebhookHandlers struct {
+	// OnAddressActivity is called for ADDRESS_ACTIVITY events.
+	OnAddressActivity func(ctx context.Context, event *WebhookEvent, activity *AddressActivityEvent) error
+	// OnNFTActivity is called for NFT_ACTIVITY events.
+	OnNFTActivity func(ctx context.Context, event *WebhookEvent, activity *NFTActivityEvent) error
+	// OnNFTMetadataUpdate is called for NFT_METADATA_UPDATE events.
+	OnNFTMetadataUpdate func(ctx context.Context, event *WebhookEvent, update *NFTMetadataUpdateEvent) error
+	// OnMinedTransaction is called for MINED_TRANSACTION events.
+	OnMinedTransaction func(ctx context.Context, event *WebhookEvent, mined *MinedTransactionEvent) error
+	// OnDroppedTransaction is called for DROPPED_TRANSACTION events.
+	OnDroppedTransaction func(ctx context.Context, event *WebhookEvent, dropped *DroppedTransactionEvent) error
+	// OnGraphQL is called for GRAPHQL events.
+	OnGraphQL func(ctx context.Context, event *WebhookEvent, gql *GraphQLEvent) error
+
+	// Deduper, if set, acknowledges redelivered events with 200 without
+	// invoking any callback. Events are marked once handled successfully.
+	Deduper *EventDeduper
+}
+
+// WebhookKeyProvider returns the signing keys accepted for a webhook.
+// During a key rotation it should return both the current and the previous
+// key so that events signed with the old key and still in flight verify.
+type WebhookKeyProvider interface {
+	// SigningKeys returns the signing keys for the webhook with the given ID.
+	SigningKeys(ctx context.Context, webhookID string) ([]string, error)
+}
+
+// WebhookKeyProviderFunc adapts a function to a WebhookKeyProvider.
+type WebhookKeyProviderFunc func(ctx context.Context, webhookID string) ([]string, error)
+
+// SigningKeys implements WebhookKeyProvider.
+func (f WebhookKeyProviderFunc) SigningKeys(ctx context.Context, webhookID string) ([]string, error) {
+	return f(ctx, webhookID)
+}
+
+// StaticWebhookKeys returns a WebhookKeyProvider that accepts keys for every webhook.
+func StaticWebhookKeys(keys ...string) WebhookKeyProvider {
+	return WebhookKeyProviderFunc(func(context.Context, string) ([]string, error) {
+		return keys, nil
+	})
+}
+
+// webhookHandler is an http.Handler that verifies and dispatches webhook events.
+type webhookHandler struct {
+	keys     WebhookKeyProvider
+	handlers WebhookHandlers
+}
+
+// NewWebhookHandler creates an http.Handler that reads the request body,
+// verifies the X-Alchemy-Signature header against signingKey, parses the
+// event, and dispatches it to the matching callback in handlers.
+// Requests with a missing or invalid signature are rejected with 401
+// without invoking any callback.
+func NewWebhookHandler(signingKey string, handlers WebhookHandlers) http.Handler {
+	return NewWebhookHandlerWithKeys(StaticWebhookKeys(signingKey), handlers)
+}
+
+// NewWebhookHandlerWithKeys is like NewWebhookHandler but looks up the
+// signing keys by the event's webhook ID and accepts a signature made with
+// any of them. If keys returns an error the request is rejected with 500 so
+// Alchemy retries delivery.
+func NewWebhookHandlerWithKeys(keys WebhookKeyProvider, handlers WebhookHandlers) http.Handler {
+	return &webhookHandler{
+		keys:     keys,
+		handlers: handlers,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *webhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := readWebhookBody(r)
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	signature := r.Header.Get(WebhookSignatureHeader)
+	if signature == "" {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	// The webhook ID selects the keys; the payload is only trusted once
+	// the signature has been verified.
+	var envelope struct {
+		WebhookID string `json:"webhookId"`
+	}
+	_ = json.Unmarshal(body, &envelope)
+
+	keys, err := h.keys.SigningKeys(r.Context(), envelope.WebhookID)
+	if err != nil {
+		http.Error(w, "failed to load signing keys", http.StatusInternalServerError)
+		return
+	}
+	if _, ok := VerifyWebhookSignatureAny(keys, signature, body); !ok {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	event, err := ParseWebhookEvent(body)
+	if err != nil {
+		http.Error(w, "invalid event payload", http.StatusBadRequest)
+		return
+	}
+
+	if dedupe := h.handlers.Deduper; dedupe != nil {
+		duplicate, err := dedupe.IsDuplicate(r.Context(), event)
+		if err != nil {
+			http.Error(w, "failed to check event", http.StatusInternalServerError)
+			return
+		}
+		if duplicate {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	if err := h.dispatch(r.Context(), event); err != nil {
+		http.Error(w, "failed to handle event", http.StatusInternalServerError)
+		return
+	}
+
+	if dedupe := h.handlers.Deduper; dedupe != nil {
+		// The event was handled; a failure to record it only risks
+		// processing a redelivery again, so it is still acknowledged.
+		_ = dedupe.MarkHandled(r.Context(), event)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch invokes the callback matching the event type.
+func (h *webhookHandler) dispatch(ctx context.Context, event *WebhookEvent) error {
+	switch WebhookType(event.Type) {
+	case WebhookTypeAddressActivity:
+		if h.handlers.OnAddressActivity == nil {
+			return nil
+		}
+		activity, err := ParseAddressActivityEvent(event)
+		if err != nil {
+			return err
+		}
+		return h.handlers.OnAddressActivity(ctx, event, activity)
+	case WebhookTypeNFTActivity:
+		if h.handlers.OnNFTActivity == nil {
+			return nil
+		}
+		activity, err := ParseNFTActivityEvent(event)
+		if err != nil {
+			return err
+		}
+		return h.handlers.OnNFTActivity(ctx, event, activity)
+	case WebhookTypeNFTMetadataUpdate:
+		if h.handlers.OnNFTMetadataUpdate == nil {
+			return nil
+		}
+		update, err := ParseNFTMetadataUpdateEvent(event)
+		if err != nil {
+			return err
+		}
+		return h.handlers.OnNFTMetadataUpdate(ctx, event, update)
+	case WebhookTypeMinedTransaction:
+		if h.handlers.OnMinedTransaction == nil {
+			return nil
+		}
+		mined, err := ParseMinedTransactionEvent(event)
+		if err != nil {
+			return err
+		}
+		return h.handlers.OnMinedTransaction(ctx, event, mined)
+	case WebhookTypeDroppedTransaction:
+		if h.handlers.OnDroppedTransaction == nil {
+			return nil
+		}
+		dropped, err := ParseDroppedTransactionEvent(event)
+		if err != nil {
+			return err
+		}
+		return h.handlers.OnDroppedTransaction(ctx, event, dropped)
+	case WebhookTypeGraphQL:
+		if h.handlers.OnGraphQL == nil {
+			return nil
+		}
+		gql, err := ParseGraphQLEvent(event)
+		if err != nil {
+			return err
+		}
+		return h.handlers.OnGraphQL(ctx, event, gql)
+	default:
+		return nil
+	}
+}
@@ -7,144 +7,181 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+// Webhook signature errors.
+var (
+	ErrMissingWebhookSignature = errors.New("missing webhook signature")
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
 )
 
+// ErrUnknownWebhookEventType is returned by ParseEvent for event types it
+// does not recognize.
+var ErrUnknownWebhookEventType = errors.New("unknown webhook event type")
+
+// maxWebhookBodySize is the maximum accepted webhook payload size.
+const maxWebhookBodySize = 10 << 20
+
+// defaultWebhookBaseURL is the base URL of the Alchemy dashboard API.
+const defaultWebhookBaseURL = "https://dashboard.alchemy.com/api"
+
 // WebhookClient provides access to Alchemy Webhook (Notify) API.
 // It requires an auth token obtained from the Alchemy dashboard.
+// It is safe for concurrent use by multiple goroutines.
 type WebhookClient struct {
-	authToken  string
-	httpClient *http.Client
-	baseURL    string
+	authToken   string
+	httpClient  *http.Client
+	baseURL     string
+	retrier     *client.Retrier
+	middlewares []client.Middleware
+	handler     client.Handler
+	timeout     time.Duration
+	// addressChunkSize is the maximum number of addresses per update request.
+	addressChunkSize int
+	// retryCreate controls whether non-idempotent create requests are retried.
+	retryCreate bool
 }
 
-// NewWebhookClient creates a new WebhookClient.
-func NewWebhookClient(authToken string, httpClient *http.Client) *WebhookClient {
-	if httpClient == nil {
-		httpClient = http.DefaultClient
-	}
-	return &WebhookClient{
-		authToken:  authToken,
-		httpClient: httpClient,
-		baseURL:    "https://dashboard.alchemy.com/api",
+// WebhookClientOption configures a WebhookClient.
+type WebhookClientOption func(*WebhookClient)
+
+// WithRetrier sets the retry policy for dashboard API requests.
+// Requests failing with 408, 429, or 5xx are retried with backoff.
+// Passing nil disables retries.
+func WithRetrier(retrier *client.Retrier) WebhookClientOption {
+	return func(c *WebhookClient) {
+		c.retrier = retrier
 	}
 }
 
-// GetAllWebhooks retrieves all webhooks for the team.
-func (c *WebhookClient) GetAllWebhooks(ctx context.Context) (*GetWebhooksResponse, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/team-webhooks", nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// WithCreateRetries controls whether CreateWebhook requests are retried
+// (default true). A create whose response is lost to a timeout or 5xx may
+// still have succeeded, so retrying it can register a duplicate webhook;
+// disable retries here to surface such failures to the caller instead.
+// Other requests are idempotent and keep using the retrier.
+func WithCreateRetries(enabled bool) WebhookClientOption {
+	return func(c *WebhookClient) {
+		c.retryCreate = enabled
 	}
+}
 
-	c.setAuthHeader(req)
+// WithHTTPClient sets the HTTP client used for dashboard API requests.
+// Passing nil uses http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) WebhookClientOption {
+	return func(c *WebhookClient) {
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+		c.httpClient = httpClient
+	}
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+// WithMiddleware appends middlewares to the request chain, e.g.
+// client.NewLoggingMiddleware. Middlewares run in the order given, once per
+// attempt.
+func WithMiddleware(middlewares ...client.Middleware) WebhookClientOption {
+	return func(c *WebhookClient) {
+		c.middlewares = append(c.middlewares, middlewares...)
 	}
-	defer resp.Body.Close()
+}
 
-	if err := c.checkResponse(resp); err != nil {
-		return nil, err
+// WithTimeout bounds each request attempt. Zero means no per-attempt
+// timeout beyond the context and the HTTP client's own Timeout.
+func WithTimeout(timeout time.Duration) WebhookClientOption {
+	return func(c *WebhookClient) {
+		c.timeout = timeout
 	}
+}
 
-	var result GetWebhooksResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+// WithBaseURL overrides the dashboard API base URL, e.g. to point at a proxy
+// or an httptest server. A trailing slash is ignored.
+func WithBaseURL(baseURL string) WebhookClientOption {
+	return func(c *WebhookClient) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
 	}
+}
 
-	return &result, nil
+// NewWebhookClient creates a new WebhookClient using httpClient.
+// It is equivalent to NewWebhookClientWithOptions with WithHTTPClient
+// applied before opts.
+func NewWebhookClient(authToken string, httpClient *http.Client, opts ...WebhookClientOption) *WebhookClient {
+	return NewWebhookClientWithOptions(authToken, append([]WebhookClientOption{WithHTTPClient(httpClient)}, opts...)...)
 }
 
-// CreateWebhook creates a new webhook.
-func (c *WebhookClient) CreateWebhook(ctx context.Context, params *CreateWebhookParams) (*CreateWebhookResponse, error) {
-	body, err := json.Marshal(params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+// NewWebhookClientWithOptions creates a new WebhookClient configured by opts.
+// By default, requests use http.DefaultClient and the dashboard API base URL,
+// and are retried using client.DefaultRetrier.
+func NewWebhookClientWithOptions(authToken string, opts ...WebhookClientOption) *WebhookClient {
+	c := &WebhookClient{
+		authToken:   authToken,
+		httpClient:  http.DefaultClient,
+		baseURL:     defaultWebhookBaseURL,
+		retrier:     client.DefaultRetrier(),
+		retryCreate: true,
 	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/create-webhook", bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	for _, opt := range opts {
+		opt(c)
 	}
 
-	c.setAuthHeader(req)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+	httpClient := c.httpClient
+	c.handler = func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return httpClient.Do(req)
 	}
-	defer resp.Body.Close()
-
-	if err := c.checkResponse(resp); err != nil {
-		return nil, err
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		c.handler = c.middlewares[i].Wrap(c.handler)
 	}
+	return c
+}
 
-	var result CreateWebhookResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+// GetAllWebhooks retrieves all webhooks for the team.
+func (c *WebhookClient) GetAllWebhooks(ctx context.Context) (*GetWebhooksResponse, error) {
+	var result GetWebhooksResponse
+	if err := c.do(ctx, http.MethodGet, "/team-webhooks", nil, &result); err != nil {
+		return nil, err
 	}
-
 	return &result, nil
 }
 
-// UpdateWebhook updates a webhook's status or name.
-func (c *WebhookClient) UpdateWebhook(ctx context.Context, params *UpdateWebhookParams) (*UpdateWebhookResponse, error) {
-	body, err := json.Marshal(params)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseURL+"/update-webhook", bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// CreateWebhook creates a new webhook. The parameters are validated first
+// (see CreateWebhookParams.Validate) so malformed requests fail locally.
+func (c *WebhookClient) CreateWebhook(ctx context.Context, params *CreateWebhookParams) (*CreateWebhookResponse, error) {
+	if params == nil {
+		return nil, fmt.Errorf("%w: params are required", sdkerrors.ErrInvalidParameter)
 	}
-
-	c.setAuthHeader(req)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if err := c.checkResponse(resp); err != nil {
+	var result CreateWebhookResponse
+	if err := c.do(ctx, http.MethodPost, "/create-webhook", params, &result); err != nil {
 		return nil, err
 	}
+	return &result, nil
+}
 
+// UpdateWebhook updates a webhook's status, name, or URL.
+func (c *WebhookClient) UpdateWebhook(ctx context.Context, params *UpdateWebhookParams) (*UpdateWebhookResponse, error) {
 	var result UpdateWebhookResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.do(ctx, http.MethodPut, "/update-webhook", params, &result); err != nil {
+		return nil, err
 	}
-
 	return &result, nil
 }
 
 // DeleteWebhook deletes a webhook.
 func (c *WebhookClient) DeleteWebhook(ctx context.Context, webhookID string) error {
-	reqURL := c.baseURL + "/delete-webhook?webhook_id=" + url.QueryEscape(webhookID)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, reqURL, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setAuthHeader(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	return c.checkResponse(resp)
+	return c.do(ctx, http.MethodDelete, "/delete-webhook?webhook_id="+url.QueryEscape(webhookID), nil, nil)
 }
 
 // GetWebhookAddresses retrieves addresses tracked by a webhook.
@@ -162,107 +199,68 @@ func (c *WebhookClient) GetWebhookAddresses(ctx context.Context, params *GetWebh
 		query.Set("pageKey", params.PageKey)
 	}
 
-	reqURL := c.baseURL + "/webhook-addresses?" + query.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setAuthHeader(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if err := c.checkResponse(resp); err != nil {
-		return nil, err
-	}
-
 	var result GetWebhookAddressesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.do(ctx, http.MethodGet, "/webhook-addresses?"+query.Encode(), nil, &result); err != nil {
+		return nil, err
 	}
-
 	return &result, nil
 }
 
 // GetAllWebhookAddresses retrieves all addresses tracked by a webhook (handles pagination).
 func (c *WebhookClient) GetAllWebhookAddresses(ctx context.Context, webhookID string) ([]string, error) {
-	var allAddresses []string
-	after := ""
-
-	for {
-		params := &GetWebhookAddressesParams{
-			WebhookID: webhookID,
-			Limit:     1000,
-			After:     after,
-		}
-
-		resp, err := c.GetWebhookAddresses(ctx, params)
-		if err != nil {
-			return nil, err
-		}
-
-		allAddresses = append(allAddresses, resp.Data...)
-
-		if !resp.HasMore() {
-			break
-		}
-		after = resp.Pagination.Cursors.After
-	}
-
-	return allAddresses, nil
+	return c.GetAllWebhookAddressesWithOptions(ctx, webhookID, nil)
 }
 
 // ReplaceWebhookAddresses replaces all addresses tracked by a webhook.
+// Addresses are normalized before sending (see
+// ReplaceWebhookAddressesParams.Normalize). Large lists are
+// split into chunks (see WithAddressChunkSize): the first chunk replaces the
+// tracked set and the remaining chunks are added sequentially. If a chunk
+// fails, a *WebhookAddressesError reports which addresses were not applied.
 func (c *WebhookClient) ReplaceWebhookAddresses(ctx context.Context, params *ReplaceWebhookAddressesParams) error {
-	body, err := json.Marshal(params)
-	if err != nil {
-		return fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseURL+"/update-webhook-addresses", bytes.NewReader(body))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	if params == nil {
+		return fmt.Errorf("%w: params are required", sdkerrors.ErrInvalidParameter)
 	}
-
-	c.setAuthHeader(req)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+	if err := params.Normalize(); err != nil {
+		return err
 	}
-	defer resp.Body.Close()
-
-	return c.checkResponse(resp)
+	return c.applyAddressChunks(ctx, params.WebhookID, replaceAddressChunks(params.Addresses, c.chunkSize()))
 }
 
 // UpdateWebhookAddresses adds or removes addresses from a webhook.
+// Addresses are normalized before sending (see
+// UpdateWebhookAddressesParams.Normalize). Large lists are
+// split into chunks (see WithAddressChunkSize) and sent sequentially,
+// additions first. If a chunk fails, a *WebhookAddressesError reports which
+// addresses were not applied.
 func (c *WebhookClient) UpdateWebhookAddresses(ctx context.Context, params *UpdateWebhookAddressesParams) error {
-	body, err := json.Marshal(params)
-	if err != nil {
-		return fmt.Errorf("failed to marshal request: %w", err)
+	if params == nil {
+		return fmt.Errorf("%w: params are required", sdkerrors.ErrInvalidParameter)
+	}
+	if err := params.Normalize(); err != nil {
+		return err
 	}
+	return c.applyAddressChunks(ctx, params.WebhookID, updateAddressChunks(params.AddressesToAdd, params.AddressesToRemove, c.chunkSize()))
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.baseURL+"/update-webhook-addresses", bytes.NewReader(body))
+// SetWebhookAddresses reconciles the addresses tracked by a webhook with the
+// desired set. It fetches the current addresses, computes the difference, and
+// issues an add/remove update (see DiffWebhookAddresses).
+// No request is made if the webhook already tracks exactly the desired set.
+func (c *WebhookClient) SetWebhookAddresses(ctx context.Context, webhookID string, addresses []string) error {
+	current, err := c.GetAllWebhookAddresses(ctx, webhookID)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return err
 	}
 
-	c.setAuthHeader(req)
-	req.Header.Set("Content-Type", "application/json")
+	params := NewUpdateWebhookAddressesParams(webhookID)
+	params.AddressesToAdd, params.AddressesToRemove = DiffWebhookAddresses(current, addresses)
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+	if len(params.AddressesToAdd) == 0 && len(params.AddressesToRemove) == 0 {
+		return nil
 	}
-	defer resp.Body.Close()
 
-	return c.checkResponse(resp)
+	return c.UpdateWebhookAddresses(ctx, params)
 }
 
 // GetNFTFilters retrieves NFT filters for a webhook.
@@ -277,55 +275,142 @@ func (c *WebhookClient) GetNFTFilters(ctx context.Context, webhookID string, lim
 		query.Set("after", after)
 	}
 
-	reqURL := c.baseURL + "/webhook-nft-filters?" + query.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	var result NFTWebhookFiltersResponse
+	if err := c.do(ctx, http.MethodGet, "/webhook-nft-filters?"+query.Encode(), nil, &result); err != nil {
+		return nil, err
 	}
+	return &result, nil
+}
 
-	c.setAuthHeader(req)
+// GetAllNFTFilters retrieves all NFT filters of a webhook, following the
+// pagination cursor.
+func (c *WebhookClient) GetAllNFTFilters(ctx context.Context, webhookID string) ([]NFTWebhookFilter, error) {
+	var filters []NFTWebhookFilter
+	after := ""
+	for {
+		resp, err := c.GetNFTFilters(ctx, webhookID, 0, after)
+		if err != nil {
+			return nil, err
+		}
+		if filters == nil {
+			filters = make([]NFTWebhookFilter, 0, resp.Pagination.TotalCount)
+		}
+		filters = append(filters, resp.Data...)
+		if !resp.HasMore() || len(resp.Data) == 0 {
+			break
+		}
+		after = resp.Pagination.Cursors.After
+	}
+	return filters, nil
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+// UpdateNFTFilters adds or removes NFT filters from a webhook.
+// The parameters are validated before sending (see UpdateNFTFiltersParams.Validate).
+func (c *WebhookClient) UpdateNFTFilters(ctx context.Context, params *UpdateNFTFiltersParams) error {
+	if params == nil {
+		return fmt.Errorf("%w: params are required", sdkerrors.ErrInvalidParameter)
 	}
-	defer resp.Body.Close()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPatch, "/update-webhook-nft-filters", params, nil)
+}
 
-	if err := c.checkResponse(resp); err != nil {
-		return nil, err
+// GetNFTMetadataFilters retrieves NFT metadata filters for a webhook.
+func (c *WebhookClient) GetNFTMetadataFilters(ctx context.Context, webhookID string, limit int, after string) (*NFTWebhookFiltersResponse, error) {
+	query := url.Values{}
+	query.Set("webhook_id", webhookID)
+
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if after != "" {
+		query.Set("after", after)
 	}
 
 	var result NFTWebhookFiltersResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.do(ctx, http.MethodGet, "/webhook-nft-metadata-filters?"+query.Encode(), nil, &result); err != nil {
+		return nil, err
 	}
-
 	return &result, nil
 }
 
-// UpdateNFTFilters adds or removes NFT filters from a webhook.
-func (c *WebhookClient) UpdateNFTFilters(ctx context.Context, params *UpdateNFTFiltersParams) error {
-	body, err := json.Marshal(params)
-	if err != nil {
-		return fmt.Errorf("failed to marshal request: %w", err)
-	}
+// UpdateNFTMetadataFilters adds or removes NFT metadata filters from a webhook.
+func (c *WebhookClient) UpdateNFTMetadataFilters(ctx context.Context, params *UpdateNFTMetadataFiltersParams) error {
+	return c.do(ctx, http.MethodPatch, "/update-webhook-nft-metadata-filters", params, nil)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.baseURL+"/update-webhook-nft-filters", bytes.NewReader(body))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+// do executes a dashboard API request with retries and decodes the JSON
+// response into result (if non-nil). The request is rebuilt for every
+// attempt since the body reader is consumed.
+func (c *WebhookClient) do(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
 	}
 
-	c.setAuthHeader(req)
-	req.Header.Set("Content-Type", "application/json")
+	var respBody []byte
+	attempt := func() error {
+		var bodyReader io.Reader
+		if payload != nil {
+			bodyReader = bytes.NewReader(payload)
+		}
+
+		attemptCtx := ctx
+		if c.timeout > 0 {
+			var cancel context.CancelFunc
+			attemptCtx, cancel = context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+		}
+
+		req, err := http.NewRequestWithContext(attemptCtx, method, c.baseURL+path, bodyReader)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+
+		c.setAuthHeader(req)
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err := c.handler(attemptCtx, req)
+		if err != nil {
+			return fmt.Errorf("failed to execute request: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if err := c.checkResponse(resp); err != nil {
+			return err
+		}
+
+		respBody, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+		return nil
+	}
 
-	resp, err := c.httpClient.Do(req)
+	var err error
+	if c.retrier != nil && (method != http.MethodPost || c.retryCreate) {
+		err = c.retrier.Do(ctx, attempt)
+	} else {
+		err = attempt()
+	}
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
-	defer resp.Body.Close()
 
-	return c.checkResponse(resp)
+	if result != nil {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // setAuthHeader sets the authentication header.
@@ -334,24 +419,80 @@ func (c *WebhookClient) setAuthHeader(req *http.Request) {
 }
 
 // checkResponse checks the HTTP response for errors.
+// Non-2xx responses are returned as *errors.WebhookAPIError, which wraps
+// *errors.HTTPError so that retryable statuses (408, 429, 5xx) are retried.
 func (c *WebhookClient) checkResponse(resp *http.Response) error {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return nil
 	}
 
 	body, _ := io.ReadAll(resp.Body)
-	return fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(body))
+	return sdkerrors.NewWebhookAPIError(resp.StatusCode, resp.Status, body)
 }
 
 // VerifyWebhookSignature verifies the signature of a webhook payload.
 // signingKey is the webhook's signing key from the dashboard.
 // signature is the value of the X-Alchemy-Signature header.
 // payload is the raw request body.
+// The comparison is performed in constant time on the decoded MAC bytes.
 func VerifyWebhookSignature(signingKey, signature string, payload []byte) bool {
-	mac := hmac.New(sha256.New, []byte(signingKey))
-	mac.Write(payload)
-	expectedSignature := hex.EncodeToString(mac.Sum(nil))
-	return hmac.Equal([]byte(signature), []byte(expectedSignature))
+	_, ok := VerifyWebhookSignatureAny([]string{signingKey}, signature, payload)
+	return ok
+}
+
+// VerifyWebhookSignatureAny verifies the signature of a webhook payload
+// against each of keys, e.g. the current and previous signing keys during a
+// key rotation. It returns the index of the first matching key, or -1 and
+// false if none match. Empty keys are skipped.
+func VerifyWebhookSignatureAny(keys []string, signature string, payload []byte) (matchedIndex int, ok bool) {
+	provided, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil || len(provided) != sha256.Size {
+		return -1, false
+	}
+
+	for i, key := range keys {
+		if key == "" {
+			continue
+		}
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write(payload)
+		if hmac.Equal(provided, mac.Sum(nil)) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// ReadAndVerify reads the request body once, verifies its X-Alchemy-Signature
+// header against signingKey, and returns the raw bytes for parsing.
+// The request body is replaced with an in-memory copy so it can be read again
+// by later handlers without breaking the HMAC.
+func ReadAndVerify(r *http.Request, signingKey string) ([]byte, error) {
+	body, err := readWebhookBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := r.Header.Get(WebhookSignatureHeader)
+	if signature == "" {
+		return nil, ErrMissingWebhookSignature
+	}
+	if !VerifyWebhookSignature(signingKey, signature, body) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	return body, nil
+}
+
+// readWebhookBody reads the request body and replaces it with an in-memory copy.
+func readWebhookBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
 }
 
 // ParseWebhookEvent parses a webhook event from the request body.
@@ -365,15 +506,86 @@ func ParseWebhookEvent(body []byte) (*WebhookEvent, error) {
 
 // ParseAddressActivityEvent parses the event data as an AddressActivityEvent.
 func ParseAddressActivityEvent(event *WebhookEvent) (*AddressActivityEvent, error) {
-	data, err := json.Marshal(event.Event)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal event data: %w", err)
-	}
-
 	var activity AddressActivityEvent
-	if err := json.Unmarshal(data, &activity); err != nil {
+	if err := decodeEventData(event, &activity); err != nil {
 		return nil, fmt.Errorf("failed to parse address activity event: %w", err)
 	}
+	return &activity, nil
+}
 
+// ParseNFTActivityEvent parses the event data as an NFTActivityEvent.
+func ParseNFTActivityEvent(event *WebhookEvent) (*NFTActivityEvent, error) {
+	var activity NFTActivityEvent
+	if err := decodeEventData(event, &activity); err != nil {
+		return nil, fmt.Errorf("failed to parse NFT activity event: %w", err)
+	}
 	return &activity, nil
 }
+
+// ParseNFTMetadataUpdateEvent parses the event data as an NFTMetadataUpdateEvent.
+func ParseNFTMetadataUpdateEvent(event *WebhookEvent) (*NFTMetadataUpdateEvent, error) {
+	var update NFTMetadataUpdateEvent
+	if err := decodeEventData(event, &update); err != nil {
+		return nil, fmt.Errorf("failed to parse NFT metadata update event: %w", err)
+	}
+	return &update, nil
+}
+
+// ParseMinedTransactionEvent parses the event data as a MinedTransactionEvent.
+func ParseMinedTransactionEvent(event *WebhookEvent) (*MinedTransactionEvent, error) {
+	var mined MinedTransactionEvent
+	if err := decodeEventData(event, &mined); err != nil {
+		return nil, fmt.Errorf("failed to parse mined transaction event: %w", err)
+	}
+	return &mined, nil
+}
+
+// ParseDroppedTransactionEvent parses the event data as a DroppedTransactionEvent.
+func ParseDroppedTransactionEvent(event *WebhookEvent) (*DroppedTransactionEvent, error) {
+	var dropped DroppedTransactionEvent
+	if err := decodeEventData(event, &dropped); err != nil {
+		return nil, fmt.Errorf("failed to parse dropped transaction event: %w", err)
+	}
+	return &dropped, nil
+}
+
+// ParseGraphQLEvent parses the event data as a GraphQLEvent.
+func ParseGraphQLEvent(event *WebhookEvent) (*GraphQLEvent, error) {
+	var gql GraphQLEvent
+	if err := decodeEventData(event, &gql); err != nil {
+		return nil, fmt.Errorf("failed to parse GraphQL event: %w", err)
+	}
+	return &gql, nil
+}
+
+// ParseEvent parses the event data according to the event type and returns
+// the typed payload: *AddressActivityEvent, *NFTActivityEvent,
+// *NFTMetadataUpdateEvent, *MinedTransactionEvent, *DroppedTransactionEvent
+// or *GraphQLEvent. Unrecognized types return ErrUnknownWebhookEventType.
+func ParseEvent(event *WebhookEvent) (interface{}, error) {
+	switch WebhookType(event.Type) {
+	case WebhookTypeAddressActivity:
+		return ParseAddressActivityEvent(event)
+	case WebhookTypeNFTActivity:
+		return ParseNFTActivityEvent(event)
+	case WebhookTypeNFTMetadataUpdate:
+		return ParseNFTMetadataUpdateEvent(event)
+	case WebhookTypeMinedTransaction:
+		return ParseMinedTransactionEvent(event)
+	case WebhookTypeDroppedTransaction:
+		return ParseDroppedTransactionEvent(event)
+	case WebhookTypeGraphQL:
+		return ParseGraphQLEvent(event)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownWebhookEventType, event.Type)
+	}
+}
+
+// decodeEventData re-decodes the generic event data into target.
+func decodeEventData(event *WebhookEvent, target interface{}) error {
+	data, err := json.Marshal(event.Event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event data: %w", err)
+	}
+	return json.Unmarshal(data, target)
+}
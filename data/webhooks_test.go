@@ -0,0 +1,119 @@
+package data
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// loadNFTActivityFixture parses an NFT_ACTIVITY webhook payload from testdata.
+func loadNFTActivityFixture(t *testing.T, name string) *NFTActivityEvent {
+	t.Helper()
+	body, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("ReadFile(%s) error = %v", name, err)
+	}
+	event, err := ParseWebhookEvent(body)
+	if err != nil {
+		t.Fatalf("ParseWebhookEvent() error = %v", err)
+	}
+	if event.Type != string(WebhookTypeNFTActivity) {
+		t.Fatalf("event type = %q, want %q", event.Type, WebhookTypeNFTActivity)
+	}
+	activity, err := ParseNFTActivityEvent(event)
+	if err != nil {
+		t.Fatalf("ParseNFTActivityEvent() error = %v", err)
+	}
+	return activity
+}
+
+func TestParseNFTActivityEvent(t *testing.T) {
+	type activity struct {
+		tokens []string // "<decimal token ID>x<amount>"
+		mint   bool
+		burn   bool
+		batch  bool
+	}
+	tests := []struct {
+		fixture string
+		network string
+		want    []activity
+	}{
+		{
+			fixture: "webhook_nft_activity_erc721.json",
+			network: "ETH_GOERLI",
+			want: []activity{
+				{tokens: []string{"1x1"}, mint: true},
+				{tokens: []string{"1x1"}},
+			},
+		},
+		{
+			fixture: "webhook_nft_activity_erc1155_single.json",
+			network: "ETH_MAINNET",
+			want: []activity{
+				{
+					tokens: []string{"61920230917474793946805106198338632086976879807045318060969615706663218577409x3"},
+					burn:   true,
+				},
+			},
+		},
+		{
+			fixture: "webhook_nft_activity_erc1155_batch.json",
+			network: "ETH_MAINNET",
+			want: []activity{
+				{tokens: []string{"650x1", "651x10", "4321x100"}, batch: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event := loadNFTActivityFixture(t, tt.fixture)
+			if event.Network != tt.network {
+				t.Errorf("Network = %q, want %q", event.Network, tt.network)
+			}
+			if len(event.Activity) != len(tt.want) {
+				t.Fatalf("got %d activities, want %d", len(event.Activity), len(tt.want))
+			}
+
+			for i, want := range tt.want {
+				a := &event.Activity[i]
+				tokens, err := a.Tokens()
+				if err != nil {
+					t.Fatalf("activity %d: Tokens() error = %v", i, err)
+				}
+				got := make([]string, len(tokens))
+				for j, token := range tokens {
+					got[j] = fmt.Sprintf("%sx%s", token.TokenID.Decimal(), token.Amount)
+				}
+				if !reflect.DeepEqual(got, want.tokens) {
+					t.Errorf("activity %d: tokens = %v, want %v", i, got, want.tokens)
+				}
+				if a.IsMint() != want.mint || a.IsBurn() != want.burn || a.IsBatch() != want.batch {
+					t.Errorf("activity %d: IsMint/IsBurn/IsBatch = %v/%v/%v, want %v/%v/%v",
+						i, a.IsMint(), a.IsBurn(), a.IsBatch(), want.mint, want.burn, want.batch)
+				}
+			}
+		})
+	}
+}
+
+func TestNFTActivityTokensInvalid(t *testing.T) {
+	bad := "not-a-number"
+	tests := []NFTActivity{
+		{ERC721TokenID: &bad},
+		{ERC1155Metadata: []ERC1155Metadata{{TokenID: bad, Value: "0x1"}}},
+		{ERC1155Metadata: []ERC1155Metadata{{TokenID: "0x1", Value: bad}}},
+	}
+	for i, a := range tests {
+		if _, err := a.Tokens(); err == nil {
+			t.Errorf("case %d: Tokens() error = nil, want error", i)
+		}
+	}
+
+	var empty NFTActivity
+	if tokens, err := empty.Tokens(); tokens != nil || err != nil {
+		t.Errorf("Tokens() with no token IDs = %v, %v; want nil, nil", tokens, err)
+	}
+}
@@ -1,5 +1,17 @@
 package data
 
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
 // WebhookType represents the type of webhook.
 type WebhookType string
 
@@ -8,6 +20,12 @@ const (
 	WebhookTypeGraphQL         WebhookType = "GRAPHQL"
 	WebhookTypeAddressActivity WebhookType = "ADDRESS_ACTIVITY"
 	WebhookTypeNFTActivity     WebhookType = "NFT_ACTIVITY"
+	// WebhookTypeNFTMetadataUpdate notifies when NFT metadata is refreshed.
+	WebhookTypeNFTMetadataUpdate WebhookType = "NFT_METADATA_UPDATE"
+	// WebhookTypeMinedTransaction notifies when a transaction sent through the app is mined.
+	WebhookTypeMinedTransaction WebhookType = "MINED_TRANSACTION"
+	// WebhookTypeDroppedTransaction notifies when a transaction sent through the app is dropped.
+	WebhookTypeDroppedTransaction WebhookType = "DROPPED_TRANSACTION"
 )
 
 // WebhookNetwork represents the network for a webhook.
@@ -92,10 +110,68 @@ type CreateWebhookParams struct {
 	Addresses []string `json:"addresses,omitempty"`
 	// NFTFilters is the list of NFT filters (for NFT_ACTIVITY webhooks).
 	NFTFilters []NFTWebhookFilter `json:"nft_filters,omitempty"`
+	// NFTMetadataFilters is the list of NFT filters (for NFT_METADATA_UPDATE webhooks).
+	NFTMetadataFilters []NFTWebhookFilter `json:"nft_metadata_filters,omitempty"`
 	// GraphQLQuery is the GraphQL query (for GRAPHQL webhooks).
 	GraphQLQuery *string `json:"graphql_query,omitempty"`
-	// AppID is the app ID to associate with the webhook (optional).
+	// AppID is the app ID to associate with the webhook (required for
+	// MINED_TRANSACTION and DROPPED_TRANSACTION webhooks).
 	AppID *string `json:"app_id,omitempty"`
+	// Name is the webhook name (optional).
+	Name *string `json:"name,omitempty"`
+}
+
+// SetName sets the webhook name.
+func (p *CreateWebhookParams) SetName(name string) *CreateWebhookParams {
+	p.Name = &name
+	return p
+}
+
+// SetAppID sets the app ID.
+func (p *CreateWebhookParams) SetAppID(appID string) *CreateWebhookParams {
+	p.AppID = &appID
+	return p
+}
+
+// Validate checks the parameters required by the webhook type: addresses
+// for ADDRESS_ACTIVITY, filters for NFT_ACTIVITY and NFT_METADATA_UPDATE, a
+// query for GRAPHQL and an app ID for MINED_TRANSACTION and
+// DROPPED_TRANSACTION. The webhook URL must be an absolute https URL.
+func (p *CreateWebhookParams) Validate() error {
+	if p.Network == "" {
+		return fmt.Errorf("%w: network is required", sdkerrors.ErrInvalidParameter)
+	}
+
+	u, err := url.Parse(p.WebhookURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("%w: webhook URL must be an absolute https URL, got %q", sdkerrors.ErrInvalidParameter, p.WebhookURL)
+	}
+
+	switch p.WebhookType {
+	case WebhookTypeAddressActivity:
+		if len(p.Addresses) == 0 {
+			return fmt.Errorf("%w: %s webhooks require at least one address", sdkerrors.ErrInvalidParameter, p.WebhookType)
+		}
+	case WebhookTypeNFTActivity:
+		if len(p.NFTFilters) == 0 {
+			return fmt.Errorf("%w: %s webhooks require at least one NFT filter", sdkerrors.ErrInvalidParameter, p.WebhookType)
+		}
+	case WebhookTypeNFTMetadataUpdate:
+		if len(p.NFTMetadataFilters) == 0 {
+			return fmt.Errorf("%w: %s webhooks require at least one NFT metadata filter", sdkerrors.ErrInvalidParameter, p.WebhookType)
+		}
+	case WebhookTypeGraphQL:
+		if p.GraphQLQuery == nil || strings.TrimSpace(*p.GraphQLQuery) == "" {
+			return fmt.Errorf("%w: %s webhooks require a query", sdkerrors.ErrInvalidParameter, p.WebhookType)
+		}
+	case WebhookTypeMinedTransaction, WebhookTypeDroppedTransaction:
+		if p.AppID == nil || *p.AppID == "" {
+			return fmt.Errorf("%w: %s webhooks require an app ID", sdkerrors.ErrInvalidParameter, p.WebhookType)
+		}
+	default:
+		return fmt.Errorf("%w: unknown webhook type %q", sdkerrors.ErrInvalidParameter, p.WebhookType)
+	}
+	return nil
 }
 
 // NewAddressActivityWebhookParams creates parameters for an ADDRESS_ACTIVITY webhook.
@@ -118,6 +194,36 @@ func NewNFTActivityWebhookParams(network WebhookNetwork, webhookURL string, filt
 	}
 }
 
+// NewNFTMetadataUpdateWebhookParams creates parameters for an NFT_METADATA_UPDATE webhook.
+func NewNFTMetadataUpdateWebhookParams(network WebhookNetwork, webhookURL string, filters []NFTWebhookFilter) *CreateWebhookParams {
+	return &CreateWebhookParams{
+		Network:            network,
+		WebhookType:        WebhookTypeNFTMetadataUpdate,
+		WebhookURL:         webhookURL,
+		NFTMetadataFilters: filters,
+	}
+}
+
+// NewMinedTransactionWebhookParams creates parameters for a MINED_TRANSACTION webhook.
+func NewMinedTransactionWebhookParams(network WebhookNetwork, webhookURL string, appID string) *CreateWebhookParams {
+	return &CreateWebhookParams{
+		Network:     network,
+		WebhookType: WebhookTypeMinedTransaction,
+		WebhookURL:  webhookURL,
+		AppID:       &appID,
+	}
+}
+
+// NewDroppedTransactionWebhookParams creates parameters for a DROPPED_TRANSACTION webhook.
+func NewDroppedTransactionWebhookParams(network WebhookNetwork, webhookURL string, appID string) *CreateWebhookParams {
+	return &CreateWebhookParams{
+		Network:     network,
+		WebhookType: WebhookTypeDroppedTransaction,
+		WebhookURL:  webhookURL,
+		AppID:       &appID,
+	}
+}
+
 // NewGraphQLWebhookParams creates parameters for a GRAPHQL (custom) webhook.
 func NewGraphQLWebhookParams(network WebhookNetwork, webhookURL string, query string) *CreateWebhookParams {
 	return &CreateWebhookParams{
@@ -136,6 +242,27 @@ type NFTWebhookFilter struct {
 	TokenID *string `json:"token_id,omitempty"`
 }
 
+// TokenIDValue returns the parsed token ID of the filter.
+// Returns false if the filter tracks all tokens of the contract.
+func (f *NFTWebhookFilter) TokenIDValue() (TokenID, bool) {
+	return optionalTokenID(f.TokenID)
+}
+
+// Validate checks that the contract address parses and that the token ID,
+// if set, is a decimal or 0x-prefixed hex number. The returned error wraps
+// errors.ErrInvalidParameter.
+func (f *NFTWebhookFilter) Validate() error {
+	if _, err := types.ParseAddress(f.ContractAddress); err != nil {
+		return fmt.Errorf("%w: NFT filter contract address %q", sdkerrors.ErrInvalidParameter, f.ContractAddress)
+	}
+	if f.TokenID != nil {
+		if _, err := ParseTokenID(*f.TokenID); err != nil {
+			return fmt.Errorf("%w: NFT filter token ID %q", sdkerrors.ErrInvalidParameter, *f.TokenID)
+		}
+	}
+	return nil
+}
+
 // CreateWebhookResponse represents the response from creating a webhook.
 type CreateWebhookResponse struct {
 	// Data contains the created webhook.
@@ -150,6 +277,8 @@ type UpdateWebhookParams struct {
 	IsActive *bool `json:"is_active,omitempty"`
 	// Name sets the webhook name.
 	Name *string `json:"name,omitempty"`
+	// WebhookURL sets the URL where webhook events are sent.
+	WebhookURL *string `json:"webhook_url,omitempty"`
 }
 
 // NewUpdateWebhookParams creates parameters for updating a webhook.
@@ -171,6 +300,12 @@ func (p *UpdateWebhookParams) SetName(name string) *UpdateWebhookParams {
 	return p
 }
 
+// SetWebhookURL sets the webhook URL.
+func (p *UpdateWebhookParams) SetWebhookURL(webhookURL string) *UpdateWebhookParams {
+	p.WebhookURL = &webhookURL
+	return p
+}
+
 // UpdateWebhookResponse represents the response from updating a webhook.
 type UpdateWebhookResponse struct {
 	// Data contains the updated webhook.
@@ -224,6 +359,18 @@ type ReplaceWebhookAddressesParams struct {
 	Addresses []string `json:"addresses"`
 }
 
+// Normalize validates each address, lowercases it and removes duplicates, in
+// place. Alchemy stores webhook addresses lowercased, so mixed-case input
+// would otherwise not match later updates.
+func (p *ReplaceWebhookAddressesParams) Normalize() error {
+	addresses, err := normalizeWebhookAddresses(p.Addresses)
+	if err != nil {
+		return err
+	}
+	p.Addresses = addresses
+	return nil
+}
+
 // UpdateWebhookAddressesParams represents the parameters for updating webhook addresses.
 type UpdateWebhookAddressesParams struct {
 	// WebhookID is the ID of the webhook.
@@ -255,6 +402,22 @@ func (p *UpdateWebhookAddressesParams) RemoveAddresses(addresses ...string) *Upd
 	return p
 }
 
+// Normalize validates each address, lowercases it and removes duplicates
+// within each list, in place. Alchemy stores webhook addresses lowercased, so
+// mixed-case input would otherwise not match later updates.
+func (p *UpdateWebhookAddressesParams) Normalize() error {
+	add, err := normalizeWebhookAddresses(p.AddressesToAdd)
+	if err != nil {
+		return err
+	}
+	remove, err := normalizeWebhookAddresses(p.AddressesToRemove)
+	if err != nil {
+		return err
+	}
+	p.AddressesToAdd, p.AddressesToRemove = add, remove
+	return nil
+}
+
 // NFTWebhookFiltersResponse represents the response from getting NFT webhook filters.
 type NFTWebhookFiltersResponse struct {
 	// Data contains the list of NFT filters.
@@ -263,6 +426,11 @@ type NFTWebhookFiltersResponse struct {
 	Pagination WebhookPagination `json:"pagination"`
 }
 
+// HasMore returns true if there are more filters available.
+func (r *NFTWebhookFiltersResponse) HasMore() bool {
+	return r.Pagination.Cursors.After != ""
+}
+
 // UpdateNFTFiltersParams represents the parameters for updating NFT webhook filters.
 type UpdateNFTFiltersParams struct {
 	// WebhookID is the ID of the webhook.
@@ -273,6 +441,54 @@ type UpdateNFTFiltersParams struct {
 	FiltersToRemove []NFTWebhookFilter `json:"nft_filters_to_remove"`
 }
 
+// NewUpdateNFTFiltersParams creates parameters for updating NFT webhook filters.
+func NewUpdateNFTFiltersParams(webhookID string) *UpdateNFTFiltersParams {
+	return &UpdateNFTFiltersParams{
+		WebhookID:       webhookID,
+		FiltersToAdd:    []NFTWebhookFilter{},
+		FiltersToRemove: []NFTWebhookFilter{},
+	}
+}
+
+// AddFilters adds filters to track.
+func (p *UpdateNFTFiltersParams) AddFilters(filters ...NFTWebhookFilter) *UpdateNFTFiltersParams {
+	p.FiltersToAdd = append(p.FiltersToAdd, filters...)
+	return p
+}
+
+// RemoveFilters removes filters from tracking.
+func (p *UpdateNFTFiltersParams) RemoveFilters(filters ...NFTWebhookFilter) *UpdateNFTFiltersParams {
+	p.FiltersToRemove = append(p.FiltersToRemove, filters...)
+	return p
+}
+
+// Validate checks the webhook ID and every filter (see NFTWebhookFilter.Validate).
+// The returned error wraps errors.ErrInvalidParameter.
+func (p *UpdateNFTFiltersParams) Validate() error {
+	if p.WebhookID == "" {
+		return fmt.Errorf("%w: webhook ID is required", sdkerrors.ErrInvalidParameter)
+	}
+	for _, filters := range [][]NFTWebhookFilter{p.FiltersToAdd, p.FiltersToRemove} {
+		for i := range filters {
+			if err := filters[i].Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateNFTMetadataFiltersParams represents the parameters for updating NFT
+// metadata webhook filters.
+type UpdateNFTMetadataFiltersParams struct {
+	// WebhookID is the ID of the webhook.
+	WebhookID string `json:"webhook_id"`
+	// FiltersToAdd is the list of filters to add.
+	FiltersToAdd []NFTWebhookFilter `json:"nft_metadata_filters_to_add"`
+	// FiltersToRemove is the list of filters to remove.
+	FiltersToRemove []NFTWebhookFilter `json:"nft_metadata_filters_to_remove"`
+}
+
 // WebhookSignatureHeader is the HTTP header containing the webhook signature.
 const WebhookSignatureHeader = "X-Alchemy-Signature"
 
@@ -318,6 +534,10 @@ type AddressActivity struct {
 	RawContract *RawContractInfo `json:"rawContract,omitempty"`
 	// Log contains log info (for token transfers).
 	Log *ActivityLog `json:"log,omitempty"`
+	// FromLabel is the label of the sender (see Client.LabelAddressActivity).
+	FromLabel string `json:"fromLabel,omitempty"`
+	// ToLabel is the label of the recipient (see Client.LabelAddressActivity).
+	ToLabel string `json:"toLabel,omitempty"`
 }
 
 // RawContractInfo contains raw contract information.
@@ -351,3 +571,244 @@ type ActivityLog struct {
 	// Removed indicates if the log was removed.
 	Removed bool `json:"removed"`
 }
+
+// NFTActivityEvent represents an NFT activity event.
+type NFTActivityEvent struct {
+	// Network is the blockchain network.
+	Network string `json:"network"`
+	// Activity contains the NFT activity details.
+	Activity []NFTActivity `json:"activity"`
+}
+
+// NFTActivity represents a single NFT transfer, mint, or burn.
+type NFTActivity struct {
+	// FromAddress is the sender address (zero address for mints).
+	FromAddress string `json:"fromAddress"`
+	// ToAddress is the recipient address (zero address for burns).
+	ToAddress string `json:"toAddress"`
+	// ContractAddress is the NFT contract address.
+	ContractAddress string `json:"contractAddress"`
+	// BlockNum is the block number (hex).
+	BlockNum string `json:"blockNum"`
+	// Hash is the transaction hash.
+	Hash string `json:"hash"`
+	// Category is the token category (erc721 or erc1155).
+	Category string `json:"category"`
+	// ERC721TokenID is the token ID for ERC721 transfers (hex).
+	ERC721TokenID *string `json:"erc721TokenId,omitempty"`
+	// ERC1155Metadata contains the token IDs and amounts for ERC1155 transfers.
+	ERC1155Metadata []ERC1155Metadata `json:"erc1155Metadata,omitempty"`
+	// Log contains log info.
+	Log *ActivityLog `json:"log,omitempty"`
+}
+
+// TokenIDValue returns the parsed ERC721 token ID.
+// Returns false for ERC1155 activity (see ERC1155Metadata).
+func (a *NFTActivity) TokenIDValue() (TokenID, bool) {
+	return optionalTokenID(a.ERC721TokenID)
+}
+
+// IsMint returns true if the activity is a mint (sent from the zero address).
+func (a *NFTActivity) IsMint() bool {
+	return isZeroAddressString(a.FromAddress)
+}
+
+// IsBurn returns true if the activity is a burn (sent to the zero address).
+func (a *NFTActivity) IsBurn() bool {
+	return isZeroAddressString(a.ToAddress)
+}
+
+// IsBatch returns true if the activity is an ERC1155 TransferBatch moving
+// more than one token ID.
+func (a *NFTActivity) IsBatch() bool {
+	return len(a.ERC1155Metadata) > 1
+}
+
+// NFTActivityToken is a single token moved by an NFT activity.
+type NFTActivityToken struct {
+	// TokenID is the token ID.
+	TokenID TokenID
+	// Amount is the number of tokens moved (always 1 for ERC721).
+	Amount *big.Int
+}
+
+// Tokens returns the tokens moved by the activity: one entry for ERC721
+// transfers and ERC1155 TransferSingle events, and one entry per token ID
+// for ERC1155 TransferBatch events.
+func (a *NFTActivity) Tokens() ([]NFTActivityToken, error) {
+	if len(a.ERC1155Metadata) == 0 {
+		if a.ERC721TokenID == nil {
+			return nil, nil
+		}
+		id, err := ParseTokenID(*a.ERC721TokenID)
+		if err != nil {
+			return nil, err
+		}
+		return []NFTActivityToken{{TokenID: id, Amount: big.NewInt(1)}}, nil
+	}
+
+	tokens := make([]NFTActivityToken, len(a.ERC1155Metadata))
+	for i, m := range a.ERC1155Metadata {
+		id, err := ParseTokenID(m.TokenID)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := parseBigInt(m.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount for token %s: %w", m.TokenID, err)
+		}
+		tokens[i] = NFTActivityToken{TokenID: id, Amount: amount}
+	}
+	return tokens, nil
+}
+
+// isZeroAddressString returns true if s is the zero address.
+func isZeroAddressString(s string) bool {
+	return strings.EqualFold(s, "0x0000000000000000000000000000000000000000")
+}
+
+// NFTMetadataUpdateEvent represents an NFT metadata update event.
+type NFTMetadataUpdateEvent struct {
+	// ContractAddress is the NFT contract address.
+	ContractAddress string `json:"contractAddress"`
+	// TokenID is the token ID whose metadata changed.
+	TokenID string `json:"tokenId"`
+	// NetworkID is the network the token is on, e.g. "ETH_MAINNET".
+	NetworkID string `json:"networkId"`
+	// UpdatedAt is when the metadata was updated (RFC 3339).
+	UpdatedAt string `json:"updatedAt"`
+	// Metadata is the new metadata snapshot.
+	Metadata *NFTRawMetadata `json:"metadata,omitempty"`
+	// RawMetadata is the new metadata snapshot as delivered, including
+	// fields not covered by Metadata.
+	RawMetadata json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping the raw metadata.
+func (e *NFTMetadataUpdateEvent) UnmarshalJSON(data []byte) error {
+	type alias NFTMetadataUpdateEvent
+	aux := struct {
+		*alias
+		Metadata json.RawMessage `json:"metadata,omitempty"`
+	}{alias: (*alias)(e)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	e.Metadata, e.RawMetadata = nil, nil
+	if len(aux.Metadata) == 0 || string(aux.Metadata) == "null" {
+		return nil
+	}
+	var metadata NFTRawMetadata
+	if err := json.Unmarshal(aux.Metadata, &metadata); err != nil {
+		return err
+	}
+	e.Metadata = &metadata
+	e.RawMetadata = aux.Metadata
+	return nil
+}
+
+// TokenIDValue returns the parsed token ID.
+func (e *NFTMetadataUpdateEvent) TokenIDValue() (TokenID, bool) {
+	return optionalTokenID(&e.TokenID)
+}
+
+// UpdatedAtTime parses UpdatedAt.
+func (e *NFTMetadataUpdateEvent) UpdatedAtTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, e.UpdatedAt)
+}
+
+// MinedTransactionEvent represents a mined transaction event.
+type MinedTransactionEvent struct {
+	// AppID is the ID of the app the transaction was sent through.
+	AppID string `json:"appId"`
+	// Network is the blockchain network.
+	Network string `json:"network"`
+	// Transaction is the mined transaction.
+	Transaction WebhookTransaction `json:"transaction"`
+}
+
+// DroppedTransactionEvent represents a dropped transaction event.
+type DroppedTransactionEvent struct {
+	// AppID is the ID of the app the transaction was sent through.
+	AppID string `json:"appId"`
+	// Network is the blockchain network.
+	Network string `json:"network"`
+	// Transaction is the dropped transaction. Block fields are not set.
+	Transaction WebhookTransaction `json:"transaction"`
+}
+
+// WebhookTransaction is a transaction delivered in a mined or dropped
+// transaction event. Numeric fields are hex strings.
+type WebhookTransaction struct {
+	// Hash is the transaction hash.
+	Hash string `json:"hash"`
+	// From is the sender address.
+	From string `json:"from"`
+	// To is the recipient address (nil for contract creation).
+	To *string `json:"to,omitempty"`
+	// Value is the value transferred in wei (hex).
+	Value string `json:"value"`
+	// Gas is the gas limit (hex).
+	Gas string `json:"gas"`
+	// GasPrice is the gas price (hex).
+	GasPrice *string `json:"gasPrice,omitempty"`
+	// MaxFeePerGas is the EIP-1559 fee cap (hex).
+	MaxFeePerGas *string `json:"maxFeePerGas,omitempty"`
+	// MaxPriorityFeePerGas is the EIP-1559 tip cap (hex).
+	MaxPriorityFeePerGas *string `json:"maxPriorityFeePerGas,omitempty"`
+	// Nonce is the sender nonce (hex).
+	Nonce string `json:"nonce"`
+	// Input is the call data.
+	Input string `json:"input"`
+	// Type is the transaction type (hex).
+	Type *string `json:"type,omitempty"`
+	// BlockHash is the block hash (mined transactions only).
+	BlockHash *string `json:"blockHash,omitempty"`
+	// BlockNumber is the block number (hex, mined transactions only).
+	BlockNumber *string `json:"blockNumber,omitempty"`
+	// TransactionIndex is the index in the block (hex, mined transactions only).
+	TransactionIndex *string `json:"transactionIndex,omitempty"`
+	// V is the signature V value.
+	V string `json:"v"`
+	// R is the signature R value.
+	R string `json:"r"`
+	// S is the signature S value.
+	S string `json:"s"`
+}
+
+// ValueBigInt returns the value transferred as a big.Int.
+func (t *WebhookTransaction) ValueBigInt() (*big.Int, error) {
+	return parseBigInt(t.Value)
+}
+
+// BlockNumberValue returns the parsed block number.
+// Returns false if the transaction has not been mined.
+func (t *WebhookTransaction) BlockNumberValue() (uint64, bool) {
+	if t.BlockNumber == nil {
+		return 0, false
+	}
+	n, err := parseBigInt(*t.BlockNumber)
+	if err != nil || !n.IsUint64() {
+		return 0, false
+	}
+	return n.Uint64(), true
+}
+
+// GraphQLEvent represents a custom (GraphQL) webhook event.
+type GraphQLEvent struct {
+	// Data is the result of the webhook's GraphQL query.
+	Data json.RawMessage `json:"data"`
+	// SequenceNumber is the monotonically increasing event sequence number.
+	SequenceNumber string `json:"sequenceNumber"`
+	// Network is the blockchain network.
+	Network string `json:"network"`
+}
+
+// DataAs unmarshals the query result into the provided target.
+func (e *GraphQLEvent) DataAs(target interface{}) error {
+	if len(e.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(e.Data, target)
+}
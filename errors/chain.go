@@ -0,0 +1,40 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// ChainIDMismatchError is returned when the endpoint reports a different
+// chain ID than the one expected for the configured network.
+type ChainIDMismatchError struct {
+	// Network is the configured network name.
+	Network string
+	// Expected is the chain ID of the configured network.
+	Expected uint64
+	// Actual is the chain ID reported by the endpoint.
+	Actual uint64
+}
+
+// Error implements the error interface.
+func (e *ChainIDMismatchError) Error() string {
+	return fmt.Sprintf("chain ID mismatch for %s: expected %d, got %d", e.Network, e.Expected, e.Actual)
+}
+
+// Code returns the error code.
+func (e *ChainIDMismatchError) Code() string {
+	return "CHAIN_ID_MISMATCH"
+}
+
+// Unwrap returns ErrChainIDMismatch.
+func (e *ChainIDMismatchError) Unwrap() error {
+	return ErrChainIDMismatch
+}
+
+// NewChainIDMismatchError creates a new ChainIDMismatchError.
+func NewChainIDMismatchError(network string, expected, actual uint64) *ChainIDMismatchError {
+	return &ChainIDMismatchError{
+		Network:  network,
+		Expected: expected,
+		Actual:   actual,
+	}
+}
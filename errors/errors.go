@@ -8,16 +8,21 @@ import (
 
 // Common sentinel errors.
 var (
-	ErrNilResponse      = errors.New("nil response")
-	ErrInvalidResponse  = errors.New("invalid response")
-	ErrContextCanceled  = errors.New("context canceled")
-	ErrContextDeadline  = errors.New("context deadline exceeded")
-	ErrInvalidAPIKey    = errors.New("invalid API key")
-	ErrRateLimited      = errors.New("rate limited")
-	ErrNetworkNotFound  = errors.New("network not found")
-	ErrInvalidAddress   = errors.New("invalid address")
-	ErrInvalidHash      = errors.New("invalid hash")
-	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrNilResponse               = errors.New("nil response")
+	ErrInvalidResponse           = errors.New("invalid response")
+	ErrContextCanceled           = errors.New("context canceled")
+	ErrContextDeadline           = errors.New("context deadline exceeded")
+	ErrInvalidAPIKey             = errors.New("invalid API key")
+	ErrRateLimited               = errors.New("rate limited")
+	ErrNetworkNotFound           = errors.New("network not found")
+	ErrInvalidAddress            = errors.New("invalid address")
+	ErrInvalidHash               = errors.New("invalid hash")
+	ErrInvalidParameter          = errors.New("invalid parameter")
+	ErrChainIDMismatch           = errors.New("chain ID mismatch")
+	ErrBudgetExceeded            = errors.New("compute unit budget exceeded")
+	ErrFixtureNotFound           = errors.New("fixture not found")
+	ErrClientClosed              = errors.New("client closed")
+	ErrWebhookNetworkUnsupported = errors.New("network not supported by webhooks")
 )
 
 // Error is the interface for all SDK errors.
@@ -102,6 +107,12 @@ func IsRetryable(err error) bool {
 		return httpErr.IsRetryable()
 	}
 
+	// Check for JSON-RPC errors
+	var rpcErr *JSONRPCError
+	if errors.As(err, &rpcErr) {
+		return rpcErr.IsRetryable()
+	}
+
 	// Check for rate limit errors
 	if errors.Is(err, ErrRateLimited) {
 		return true
@@ -153,6 +164,11 @@ func IsRateLimitError(err error) bool {
 		return httpErr.StatusCode == 429
 	}
 
+	var rpcErr *JSONRPCError
+	if errors.As(err, &rpcErr) {
+		return rpcErr.Code == AlchemyRateLimitCode
+	}
+
 	var apiErr *APIError
 	if errors.As(err, &apiErr) {
 		return apiErr.Type == ErrTypeRateLimitExceeded
@@ -3,6 +3,7 @@ package errors
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // Standard JSON-RPC error codes.
@@ -80,12 +81,44 @@ func (e *JSONRPCError) IsServerError() bool {
 	return e.Code >= ServerErrorStart && e.Code <= ServerErrorEnd
 }
 
+// AlchemyRateLimitCode is the JSON-RPC error code Alchemy returns when the
+// compute unit capacity is exceeded.
+const AlchemyRateLimitCode = 429
+
+// transientJSONRPCMessages are fragments of JSON-RPC error messages that
+// indicate a transient node-side failure worth retrying.
+var transientJSONRPCMessages = []string{
+	"execution aborted (timeout",
+	"request timed out",
+	"timeout exceeded",
+	"header not found",
+	"upstream timeout",
+	"upstream request timeout",
+	"upstream connect error",
+	"temporarily unavailable",
+	"service unavailable",
+	"try again",
+	"capacity limit exceeded",
+	"compute units per second",
+	"rate limit",
+}
+
 // IsRetryable returns true if the error might be retryable.
+// Internal errors (-32603), Alchemy rate limiting (429), and errors whose
+// message matches a known transient failure are retryable. Other server
+// errors (-32000 to -32099) cover permanent conditions such as "nonce too
+// low" and are only retried when their message is recognized as transient.
 func (e *JSONRPCError) IsRetryable() bool {
-	// Server errors might be transient
-	if e.IsServerError() || e.IsInternalError() {
+	if e.IsInternalError() || e.Code == AlchemyRateLimitCode {
 		return true
 	}
+
+	msg := strings.ToLower(e.Message)
+	for _, fragment := range transientJSONRPCMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
 	return false
 }
 
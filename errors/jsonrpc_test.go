@@ -0,0 +1,27 @@
+package errors
+
+import "testing"
+
+func TestJSONRPCErrorIsRetryable(t *testing.T) {
+	tests := []struct {
+		code    int
+		message string
+		want    bool
+	}{
+		{-32603, "internal error", true},
+		{AlchemyRateLimitCode, "Your app has exceeded its compute units per second capacity", true},
+		{-32000, "upstream timeout", true},
+		{-32000, "Upstream connect error or disconnect/reset before headers", true},
+		{-32000, "header not found", true},
+		{-32000, "upstream returned invalid params", false},
+		{-32000, "nonce too low", false},
+		{-32602, "invalid argument 0: hex string has length 3", false},
+	}
+
+	for _, tt := range tests {
+		e := &JSONRPCError{Code: tt.code, Message: tt.message}
+		if got := e.IsRetryable(); got != tt.want {
+			t.Errorf("IsRetryable(%d %q) = %v, want %v", tt.code, tt.message, got, tt.want)
+		}
+	}
+}
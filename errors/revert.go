@@ -0,0 +1,109 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/abi"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+)
+
+// Revert data selectors.
+const (
+	// RevertSelectorError is the selector of Error(string).
+	RevertSelectorError = "0x08c379a0"
+	// RevertSelectorPanic is the selector of Panic(uint256).
+	RevertSelectorPanic = "0x4e487b71"
+)
+
+// panicReasons maps Solidity panic codes to their meaning.
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// RevertData returns the hex-encoded revert data carried by the error.
+// Nodes return it either as a plain hex string or nested as {"data": "0x..."}.
+// Returns false if the error carries no revert data.
+func (e *JSONRPCError) RevertData() (string, bool) {
+	if len(e.Data) == 0 {
+		return "", false
+	}
+
+	var s string
+	if err := json.Unmarshal(e.Data, &s); err != nil {
+		var nested struct {
+			Data string `json:"data"`
+		}
+		if err := json.Unmarshal(e.Data, &nested); err != nil {
+			return "", false
+		}
+		s = nested.Data
+	}
+
+	if !hex.Has0xPrefix(s) || !hex.IsValidHex(s) {
+		return "", false
+	}
+	return s, true
+}
+
+// DecodeRevertReason decodes the revert reason from a JSON-RPC error returned
+// by a reverted eth_call or eth_estimateGas.
+// It recognizes Error(string) reverts and Solidity Panic(uint256) codes.
+// Returns false if the data is absent or uses a custom error selector.
+func DecodeRevertReason(e *JSONRPCError) (string, bool) {
+	if e == nil {
+		return "", false
+	}
+	data, ok := e.RevertData()
+	if !ok {
+		return "", false
+	}
+
+	b, err := hex.Decode(data)
+	if err != nil || len(b) < 4 {
+		return "", false
+	}
+	selector := strings.ToLower(hex.Encode(b[:4]))
+	payload := b[4:]
+
+	switch selector {
+	case RevertSelectorError:
+		return decodeErrorString(payload)
+	case RevertSelectorPanic:
+		if len(payload) < 32 {
+			return "", false
+		}
+		code := new(big.Int).SetBytes(payload[:32])
+		if code.IsUint64() {
+			if reason, ok := panicReasons[code.Uint64()]; ok {
+				return fmt.Sprintf("panic: %s (0x%02x)", reason, code.Uint64()), true
+			}
+		}
+		return fmt.Sprintf("panic: unknown code 0x%x", code), true
+	default:
+		return "", false
+	}
+}
+
+// decodeErrorString decodes the ABI-encoded string argument of Error(string).
+func decodeErrorString(payload []byte) (string, bool) {
+	if len(payload) < 2*abi.WordSize {
+		return "", false
+	}
+	msg, err := abi.DecodeString(payload)
+	if err != nil {
+		return "", false
+	}
+	return msg, true
+}
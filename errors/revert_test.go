@@ -0,0 +1,58 @@
+package errors
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// revertError builds a JSONRPCError carrying the given hex revert data.
+func revertError(data string) *JSONRPCError {
+	raw, _ := json.Marshal(data)
+	return &JSONRPCError{Code: 3, Message: "execution reverted", Data: raw}
+}
+
+// hexWord returns a 32-byte word as 64 hex characters, left-padded with zeros.
+func hexWord(s string) string {
+	return strings.Repeat("0", 64-len(s)) + s
+}
+
+func TestDecodeRevertReasonErrorString(t *testing.T) {
+	data := RevertSelectorError + hexWord("20") + hexWord("05") + "68656c6c6f" + strings.Repeat("0", 54)
+
+	got, ok := DecodeRevertReason(revertError(data))
+	if !ok || got != "hello" {
+		t.Errorf("DecodeRevertReason() = %q, %v; want %q, true", got, ok, "hello")
+	}
+}
+
+func TestDecodeRevertReasonPanic(t *testing.T) {
+	got, ok := DecodeRevertReason(revertError(RevertSelectorPanic + hexWord("11")))
+	want := "panic: arithmetic overflow or underflow (0x11)"
+	if !ok || got != want {
+		t.Errorf("DecodeRevertReason() = %q, %v; want %q, true", got, ok, want)
+	}
+}
+
+func TestDecodeRevertReasonMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"selector only", RevertSelectorError},
+		{"truncated payload", RevertSelectorError + hexWord("20") + "0005"},
+		{"truncated string", RevertSelectorError + hexWord("20") + hexWord("40") + "68656c6c6f"},
+		{"offset out of range", RevertSelectorError + hexWord("ffffffffffffffff") + hexWord("05")},
+		{"length out of range", RevertSelectorError + hexWord("20") + hexWord("ffffffffffffffff")},
+		{"truncated panic", RevertSelectorPanic + "11"},
+		{"custom error", "0xdeadbeef" + hexWord("01")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := DecodeRevertReason(revertError(tt.data)); ok {
+				t.Errorf("DecodeRevertReason() = %q, true; want false", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// WebhookAPIError represents an error response from the Alchemy dashboard
+// (Notify) API. It wraps the underlying HTTPError, so IsAuthError,
+// IsRateLimitError and IsRetryable classify it by status code.
+type WebhookAPIError struct {
+	// StatusCode is the HTTP status code.
+	StatusCode int
+	// Message is the error message reported by the API, or the HTTP status
+	// text if the body could not be parsed.
+	Message string
+	// Body is the raw response body.
+	Body []byte
+
+	http *HTTPError
+}
+
+// Error implements the error interface.
+func (e *WebhookAPIError) Error() string {
+	return fmt.Sprintf("webhook API error (HTTP %d): %s", e.StatusCode, e.Message)
+}
+
+// Code returns the error code.
+func (e *WebhookAPIError) Code() string {
+	return fmt.Sprintf("WEBHOOK_HTTP_%d", e.StatusCode)
+}
+
+// Unwrap returns the underlying HTTPError.
+func (e *WebhookAPIError) Unwrap() error {
+	return e.http
+}
+
+// IsNotFound returns true if the webhook or resource does not exist.
+func (e *WebhookAPIError) IsNotFound() bool {
+	return e.StatusCode == 404
+}
+
+// NewWebhookAPIError creates a WebhookAPIError from a dashboard API
+// response, extracting the message from its JSON error body.
+func NewWebhookAPIError(statusCode int, status string, body []byte) *WebhookAPIError {
+	message := webhookErrorMessage(body)
+	if message == "" {
+		message = status
+	}
+	return &WebhookAPIError{
+		StatusCode: statusCode,
+		Message:    message,
+		Body:       body,
+		http:       NewHTTPError(statusCode, status, body),
+	}
+}
+
+// webhookErrorMessage extracts the message from a dashboard API error body:
+// {"message": "..."}, {"error": "..."} or {"error": {"message": "..."}}.
+func webhookErrorMessage(body []byte) string {
+	var resp struct {
+		Message string          `json:"message"`
+		Error   json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return strings.TrimSpace(string(body))
+	}
+	if resp.Message != "" {
+		return resp.Message
+	}
+
+	var s string
+	if json.Unmarshal(resp.Error, &s) == nil && s != "" {
+		return s
+	}
+	var nested struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(resp.Error, &nested) == nil {
+		return nested.Message
+	}
+	return ""
+}
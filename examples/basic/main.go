@@ -65,6 +65,8 @@ func main() {
 	block, err := client.Node.GetBlockByNumber(ctx, "latest", false)
 	if err != nil {
 		log.Printf("Failed to get block: %v", err)
+	} else if block == nil {
+		log.Printf("Latest block not found")
 	} else {
 		fmt.Printf("Block hash: %s\n", block.Hash)
 		fmt.Printf("Block number: %d\n", block.Number.Uint64())
@@ -66,8 +66,10 @@ func main() {
 			fmt.Printf("  Name: %s\n", *nft.Name)
 		}
 
-		if nft.Image != nil && nft.Image.ThumbnailURL != nil {
-			fmt.Printf("  Thumbnail: %s\n", *nft.Image.ThumbnailURL)
+		if nft.Image != nil {
+			if thumbnail, ok := nft.Image.BestThumbnailURL(); ok {
+				fmt.Printf("  Thumbnail: %s\n", thumbnail)
+			}
 		}
 
 		fmt.Println()
@@ -0,0 +1,94 @@
+// Package abi provides minimal Solidity ABI encoding and decoding helpers
+// for the static calls made by the SDK.
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+)
+
+// WordSize is the size of an ABI word in bytes.
+const WordSize = 32
+
+// Selector decodes a 0x-prefixed 4-byte function selector.
+func Selector(s string) []byte {
+	return hex.MustDecode(s)
+}
+
+// EncodeCall concatenates a function selector and its encoded arguments.
+func EncodeCall(selector []byte, args ...[]byte) []byte {
+	data := make([]byte, 0, len(selector)+len(args)*WordSize)
+	data = append(data, selector...)
+	for _, arg := range args {
+		data = append(data, arg...)
+	}
+	return data
+}
+
+// Uint256 encodes n as a 32-byte big-endian word.
+func Uint256(n *big.Int) []byte {
+	word := make([]byte, WordSize)
+	if n != nil {
+		n.FillBytes(word)
+	}
+	return word
+}
+
+// Address encodes a 20-byte address as a left-padded 32-byte word.
+func Address(addr []byte) []byte {
+	word := make([]byte, WordSize)
+	copy(word[WordSize-len(addr):], addr)
+	return word
+}
+
+// DecodeUint256 decodes the word at the given index.
+func DecodeUint256(data []byte, index int) (*big.Int, error) {
+	start := index * WordSize
+	if start+WordSize > len(data) {
+		return nil, fmt.Errorf("abi: data too short for word %d", index)
+	}
+	return new(big.Int).SetBytes(data[start : start+WordSize]), nil
+}
+
+// DecodeString decodes a single dynamic string return value.
+// As a fallback for old tokens, a 32-byte right-padded bytes32 value is
+// decoded as a string with trailing zero bytes removed.
+func DecodeString(data []byte) (string, error) {
+	if len(data) == WordSize {
+		end := 0
+		for end < len(data) && data[end] != 0 {
+			end++
+		}
+		if !utf8.Valid(data[:end]) {
+			return "", fmt.Errorf("abi: invalid bytes32 string")
+		}
+		return string(data[:end]), nil
+	}
+
+	if len(data) < 2*WordSize {
+		return "", fmt.Errorf("abi: data too short for string")
+	}
+
+	// Bounds are checked by subtraction so that huge offsets and lengths
+	// cannot overflow.
+	size := uint64(len(data))
+	offset := new(big.Int).SetBytes(data[:WordSize])
+	if !offset.IsUint64() || offset.Uint64() > size-WordSize {
+		return "", fmt.Errorf("abi: invalid string offset")
+	}
+	start := offset.Uint64() + WordSize
+
+	length := new(big.Int).SetBytes(data[start-WordSize : start])
+	if !length.IsUint64() || length.Uint64() > size-start {
+		return "", fmt.Errorf("abi: invalid string length")
+	}
+
+	s := data[start : start+length.Uint64()]
+	if !utf8.Valid(s) {
+		return "", fmt.Errorf("abi: invalid UTF-8 string")
+	}
+	return string(s), nil
+}
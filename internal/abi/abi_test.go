@@ -0,0 +1,65 @@
+package abi
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+// word encodes n as a 32-byte word.
+func word(n uint64) []byte {
+	return Uint256(new(big.Int).SetUint64(n))
+}
+
+// paddedString encodes s as right-padded ABI bytes.
+func paddedString(s string) []byte {
+	b := make([]byte, (len(s)+WordSize-1)/WordSize*WordSize)
+	copy(b, s)
+	return b
+}
+
+func TestDecodeString(t *testing.T) {
+	data := bytes.Join([][]byte{word(32), word(5), paddedString("hello")}, nil)
+
+	got, err := DecodeString(data)
+	if err != nil {
+		t.Fatalf("DecodeString() error = %v", err)
+	}
+	if got != "hello" {
+		t.Errorf("DecodeString() = %q, want %q", got, "hello")
+	}
+}
+
+func TestDecodeStringBytes32(t *testing.T) {
+	got, err := DecodeString(paddedString("MKR"))
+	if err != nil {
+		t.Fatalf("DecodeString() error = %v", err)
+	}
+	if got != "MKR" {
+		t.Errorf("DecodeString() = %q, want %q", got, "MKR")
+	}
+}
+
+func TestDecodeStringMalformed(t *testing.T) {
+	maxUint64 := word(^uint64(0))
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"too short", make([]byte, 40)},
+		{"huge offset", bytes.Join([][]byte{maxUint64, word(0)}, nil)},
+		{"offset past end", bytes.Join([][]byte{word(64), word(0)}, nil)},
+		{"offset wider than uint64", bytes.Join([][]byte{bytes.Repeat([]byte{0xff}, WordSize), word(0)}, nil)},
+		{"huge length", bytes.Join([][]byte{word(32), maxUint64}, nil)},
+		{"length past end", bytes.Join([][]byte{word(32), word(33), paddedString("x")}, nil)},
+		{"invalid UTF-8", bytes.Join([][]byte{word(32), word(1), paddedString("\xff")}, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := DecodeString(tt.data); err == nil {
+				t.Errorf("DecodeString() = %q, want error", got)
+			}
+		})
+	}
+}
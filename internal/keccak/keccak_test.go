@@ -0,0 +1,47 @@
+package keccak
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// pattern returns n bytes counting up from zero.
+func pattern(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+func TestSum256(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  string
+	}{
+		{"empty", nil, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"abc", []byte("abc"), "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+		// Lengths around the 136-byte rate exercise the padding boundaries.
+		{"135 bytes", pattern(135), "cbdfd9dee5faad3818d6b06f95a219fd290b0e1706f6a82e5a595b9ce9faca62"},
+		{"136 bytes", pattern(136), "7ce759f1ab7f9ce437719970c26b0a66ff11fe3e38e17df89cf5d29c7d7f807e"},
+		{"137 bytes", pattern(137), "ac73d4fae68b8453f764007c1a20ce95994187861f0c3227a3a8e99a73a3b1db"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Sum256(tt.input)
+			if hex.EncodeToString(got[:]) != tt.want {
+				t.Errorf("Sum256() = %x, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSum256Concatenates(t *testing.T) {
+	data := pattern(300)
+	want := Sum256(data)
+	if got := Sum256(data[:100], nil, data[100:137], data[137:]); got != want {
+		t.Errorf("Sum256(parts...) = %x, want %x", got, want)
+	}
+}
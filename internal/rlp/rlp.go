@@ -0,0 +1,157 @@
+// Package rlp implements the Recursive Length Prefix encoding used by
+// Ethereum transactions.
+package rlp
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Bytes encodes a byte string.
+func Bytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+	return append(header(0x80, len(b)), b...)
+}
+
+// Uint encodes a non-negative integer as a minimal big-endian byte string.
+// A nil value encodes as zero.
+func Uint(n *big.Int) []byte {
+	if n == nil {
+		return Bytes(nil)
+	}
+	return Bytes(n.Bytes())
+}
+
+// Uint64 encodes a uint64 as a minimal big-endian byte string.
+func Uint64(n uint64) []byte {
+	return Uint(new(big.Int).SetUint64(n))
+}
+
+// List encodes a list of already-encoded items.
+func List(items ...[]byte) []byte {
+	size := 0
+	for _, item := range items {
+		size += len(item)
+	}
+	out := header(0xc0, size)
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+// header returns the prefix for a string (offset 0x80) or list (offset 0xc0)
+// payload of the given size.
+func header(offset byte, size int) []byte {
+	if size < 56 {
+		return []byte{offset + byte(size)}
+	}
+	sizeBytes := new(big.Int).SetUint64(uint64(size)).Bytes()
+	return append([]byte{offset + 55 + byte(len(sizeBytes))}, sizeBytes...)
+}
+
+// Item is a decoded RLP value: either a byte string or a list.
+type Item struct {
+	// IsList reports whether the item is a list.
+	IsList bool
+	// Bytes is the payload of a byte string item.
+	Bytes []byte
+	// List contains the elements of a list item.
+	List []Item
+	// Raw is the complete encoding of the item, including its header.
+	Raw []byte
+}
+
+// Decode decodes a single RLP item that must span all of b.
+func Decode(b []byte) (Item, error) {
+	item, rest, err := decode(b)
+	if err != nil {
+		return Item{}, err
+	}
+	if len(rest) > 0 {
+		return Item{}, fmt.Errorf("rlp: %d trailing bytes", len(rest))
+	}
+	return item, nil
+}
+
+// decode decodes the first item in b and returns the remaining bytes.
+func decode(b []byte) (Item, []byte, error) {
+	if len(b) == 0 {
+		return Item{}, nil, errors.New("rlp: unexpected end of input")
+	}
+
+	prefix := b[0]
+	var isList bool
+	var offset, size int
+
+	switch {
+	case prefix < 0x80:
+		return Item{Bytes: b[:1], Raw: b[:1]}, b[1:], nil
+	case prefix < 0xb8:
+		offset, size = 1, int(prefix-0x80)
+	case prefix < 0xc0:
+		n := int(prefix - 0xb7)
+		s, err := readSize(b, n)
+		if err != nil {
+			return Item{}, nil, err
+		}
+		offset, size = 1+n, s
+	case prefix < 0xf8:
+		isList = true
+		offset, size = 1, int(prefix-0xc0)
+	default:
+		isList = true
+		n := int(prefix - 0xf7)
+		s, err := readSize(b, n)
+		if err != nil {
+			return Item{}, nil, err
+		}
+		offset, size = 1+n, s
+	}
+
+	if size < 0 || offset+size > len(b) {
+		return Item{}, nil, errors.New("rlp: item exceeds input length")
+	}
+	payload := b[offset : offset+size]
+	item := Item{IsList: isList, Raw: b[:offset+size]}
+
+	if !isList {
+		if size == 1 && payload[0] < 0x80 {
+			return Item{}, nil, errors.New("rlp: non-canonical single byte")
+		}
+		item.Bytes = payload
+		return item, b[offset+size:], nil
+	}
+
+	item.List = []Item{}
+	for len(payload) > 0 {
+		child, rest, err := decode(payload)
+		if err != nil {
+			return Item{}, nil, err
+		}
+		item.List = append(item.List, child)
+		payload = rest
+	}
+	return item, b[offset+size:], nil
+}
+
+// readSize reads an n-byte big-endian length following the prefix byte.
+func readSize(b []byte, n int) (int, error) {
+	if n > 8 || 1+n > len(b) {
+		return 0, errors.New("rlp: invalid length prefix")
+	}
+	if b[1] == 0 {
+		return 0, errors.New("rlp: non-canonical length")
+	}
+	var size uint64
+	for _, c := range b[1 : 1+n] {
+		size = size<<8 | uint64(c)
+	}
+	if size < 56 || size > uint64(len(b)) {
+		return 0, errors.New("rlp: invalid length")
+	}
+	return int(size), nil
+}
@@ -0,0 +1,98 @@
+package rlp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	long := strings.Repeat("a", 56)
+	tests := []struct {
+		name string
+		got  []byte
+		want string
+	}{
+		{"empty string", Bytes(nil), "80"},
+		{"single byte", Bytes([]byte{0x0f}), "0f"},
+		{"single high byte", Bytes([]byte{0x80}), "8180"},
+		{"dog", Bytes([]byte("dog")), "83646f67"},
+		{"56-byte string", Bytes([]byte(long)), "b838" + hex.EncodeToString([]byte(long))},
+		{"zero", Uint64(0), "80"},
+		{"nil integer", Uint(nil), "80"},
+		{"15", Uint64(15), "0f"},
+		{"1024", Uint64(1024), "820400"},
+		{"big integer", Uint(new(big.Int).Lsh(big.NewInt(1), 64)), "89010000000000000000"},
+		{"empty list", List(), "c0"},
+		{"cat dog", List(Bytes([]byte("cat")), Bytes([]byte("dog"))), "c88363617483646f67"},
+		{"set of three", List(List(), List(List()), List(List(), List(List()))), "c7c0c1c0c3c0c1c0"},
+	}
+
+	for _, tt := range tests {
+		if got := hex.EncodeToString(tt.got); got != tt.want {
+			t.Errorf("%s: encoded %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeRoundTrip(t *testing.T) {
+	long := bytes.Repeat([]byte{0xaa}, 1024)
+	encoded := List(Bytes([]byte("cat")), List(Uint64(1024), Bytes(long)), Bytes(nil))
+
+	item, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !item.IsList || len(item.List) != 3 {
+		t.Fatalf("Decode() = %+v, want a 3-item list", item)
+	}
+	if string(item.List[0].Bytes) != "cat" {
+		t.Errorf("item 0 = %q, want cat", item.List[0].Bytes)
+	}
+	inner := item.List[1]
+	if !inner.IsList || len(inner.List) != 2 || !bytes.Equal(inner.List[0].Bytes, []byte{0x04, 0x00}) ||
+		!bytes.Equal(inner.List[1].Bytes, long) {
+		t.Errorf("item 1 = %+v, want [1024, long string]", inner)
+	}
+	if item.List[2].IsList || len(item.List[2].Bytes) != 0 {
+		t.Errorf("item 2 = %+v, want empty string", item.List[2])
+	}
+	if !bytes.Equal(item.Raw, encoded) || !bytes.Equal(inner.Raw, List(Uint64(1024), Bytes(long))) {
+		t.Error("Raw does not hold the complete encoding")
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty input", ""},
+		{"trailing bytes", "83646f6700"},
+		{"trailing bytes after list", "c0c0"},
+		{"non-canonical single byte", "8105"},
+		{"non-canonical single byte in list", "c28105"},
+		{"long form for short string", "b803646f67"},
+		{"long form for short list", "f803c0c0c0"},
+		{"length with leading zero", "b90038" + strings.Repeat("61", 56)},
+		{"truncated string", "83646f"},
+		{"truncated list", "c883636174"},
+		{"truncated length", "b9"},
+		{"length beyond input", "b8ff61"},
+		{"child overruns list", "c283646f67"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := hex.DecodeString(tt.input)
+			if err != nil {
+				t.Fatalf("bad test input: %v", err)
+			}
+			if item, err := Decode(input); err == nil {
+				t.Errorf("Decode(%s) = %+v, want error", tt.input, item)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Package secp256k1 implements public key recovery on the secp256k1 curve
+// for verifying Ethereum transaction signatures. It is not constant-time and
+// must not be used with secret keys.
+package secp256k1
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Curve parameters.
+var (
+	p, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+	// N is the order of the base point.
+	N, _  = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+	gx, _ = new(big.Int).SetString("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", 16)
+	gy, _ = new(big.Int).SetString("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8", 16)
+	b     = big.NewInt(7)
+
+	// halfN is N/2, the upper bound for s in canonical (EIP-2) signatures.
+	halfN = new(big.Int).Rsh(N, 1)
+	// sqrtExp is (p+1)/4, used to compute square roots modulo p.
+	sqrtExp = new(big.Int).Rsh(new(big.Int).Add(p, big.NewInt(1)), 2)
+)
+
+// Errors returned by RecoverPubkey.
+var (
+	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrInvalidRecoveryID = errors.New("invalid recovery id")
+)
+
+// point is an affine curve point; nil coordinates denote infinity.
+type point struct {
+	x, y *big.Int
+}
+
+func (pt point) isInfinity() bool {
+	return pt.x == nil
+}
+
+// RecoverPubkey recovers the uncompressed public key (64 bytes, X || Y) that
+// produced the signature (r, s) with recovery id recID over hash.
+// When strict is true, signatures with s > N/2 are rejected per EIP-2.
+func RecoverPubkey(hash []byte, r, s *big.Int, recID byte, strict bool) ([]byte, error) {
+	if recID > 3 {
+		return nil, ErrInvalidRecoveryID
+	}
+	if r.Sign() <= 0 || r.Cmp(N) >= 0 || s.Sign() <= 0 || s.Cmp(N) >= 0 {
+		return nil, ErrInvalidSignature
+	}
+	if strict && s.Cmp(halfN) > 0 {
+		return nil, ErrInvalidSignature
+	}
+
+	// Reconstruct R from its x coordinate and the parity of y.
+	x := new(big.Int).Set(r)
+	if recID&2 != 0 {
+		x.Add(x, N)
+		if x.Cmp(p) >= 0 {
+			return nil, ErrInvalidSignature
+		}
+	}
+	y, ok := decompress(x, recID&1 == 1)
+	if !ok {
+		return nil, ErrInvalidSignature
+	}
+	R := point{x, y}
+
+	// Q = r^-1 (sR - eG)
+	e := new(big.Int).SetBytes(hash)
+	e.Mod(e, N)
+	rInv := new(big.Int).ModInverse(r, N)
+
+	u1 := new(big.Int).Neg(e)
+	u1.Mul(u1, rInv).Mod(u1, N)
+	u2 := new(big.Int).Mul(s, rInv)
+	u2.Mod(u2, N)
+
+	q := add(scalarMult(point{gx, gy}, u1), scalarMult(R, u2))
+	if q.isInfinity() {
+		return nil, ErrInvalidSignature
+	}
+
+	out := make([]byte, 64)
+	q.x.FillBytes(out[:32])
+	q.y.FillBytes(out[32:])
+	return out, nil
+}
+
+// decompress returns the y coordinate for x with the requested parity.
+func decompress(x *big.Int, odd bool) (*big.Int, bool) {
+	// y^2 = x^3 + 7
+	y2 := new(big.Int).Exp(x, big.NewInt(3), p)
+	y2.Add(y2, b).Mod(y2, p)
+
+	y := new(big.Int).Exp(y2, sqrtExp, p)
+	if new(big.Int).Exp(y, big.NewInt(2), p).Cmp(y2) != 0 {
+		return nil, false
+	}
+	if (y.Bit(0) == 1) != odd {
+		y.Sub(p, y)
+	}
+	return y, true
+}
+
+// add returns a + b.
+func add(a, b point) point {
+	if a.isInfinity() {
+		return b
+	}
+	if b.isInfinity() {
+		return a
+	}
+
+	var lambda *big.Int
+	if a.x.Cmp(b.x) == 0 {
+		if a.y.Cmp(b.y) != 0 || a.y.Sign() == 0 {
+			return point{}
+		}
+		// lambda = 3x^2 / 2y
+		num := new(big.Int).Mul(a.x, a.x)
+		num.Mul(num, big.NewInt(3))
+		den := new(big.Int).Lsh(a.y, 1)
+		lambda = num.Mul(num, den.ModInverse(den, p))
+	} else {
+		// lambda = (y2 - y1) / (x2 - x1)
+		num := new(big.Int).Sub(b.y, a.y)
+		den := new(big.Int).Sub(b.x, a.x)
+		den.Mod(den, p)
+		lambda = num.Mul(num, den.ModInverse(den, p))
+	}
+	lambda.Mod(lambda, p)
+
+	x := new(big.Int).Mul(lambda, lambda)
+	x.Sub(x, a.x).Sub(x, b.x).Mod(x, p)
+
+	y := new(big.Int).Sub(a.x, x)
+	y.Mul(y, lambda).Sub(y, a.y).Mod(y, p)
+
+	return point{x, y}
+}
+
+// scalarMult returns k * pt using double-and-add.
+func scalarMult(pt point, k *big.Int) point {
+	result := point{}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		result = add(result, result)
+		if k.Bit(i) == 1 {
+			result = add(result, pt)
+		}
+	}
+	return result
+}
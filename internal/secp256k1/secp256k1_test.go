@@ -0,0 +1,81 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// The EIP-155 example transaction, signed with private key 0x4646...46.
+var (
+	testHash, _ = hex.DecodeString("daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53")
+	testR, _    = new(big.Int).SetString("28ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276", 16)
+	testS, _    = new(big.Int).SetString("67cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83", 16)
+	testPubkey  = "4bc2a31265153f07e70e0bab08724e6b85e217f8cd628ceb62974247bb493382" +
+		"ce28cab79ad7119ee1ad3ebcdb98a16805211530ecc6cfefa1b88e6dff99232a"
+)
+
+func TestRecoverPubkey(t *testing.T) {
+	pub, err := RecoverPubkey(testHash, testR, testS, 0, true)
+	if err != nil {
+		t.Fatalf("RecoverPubkey() error = %v", err)
+	}
+	if got := hex.EncodeToString(pub); got != testPubkey {
+		t.Errorf("RecoverPubkey() = %s, want %s", got, testPubkey)
+	}
+
+	// The other recovery id yields a different, valid key.
+	other, err := RecoverPubkey(testHash, testR, testS, 1, true)
+	if err != nil {
+		t.Fatalf("RecoverPubkey(recID 1) error = %v", err)
+	}
+	if hex.EncodeToString(other) == testPubkey {
+		t.Error("RecoverPubkey(recID 1) returned the signer's key")
+	}
+}
+
+func TestRecoverPubkeyHighS(t *testing.T) {
+	// (r, N-s) with the opposite recovery id is the same signature's
+	// malleable twin.
+	highS := new(big.Int).Sub(N, testS)
+
+	if _, err := RecoverPubkey(testHash, testR, highS, 1, true); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("strict RecoverPubkey() error = %v, want ErrInvalidSignature", err)
+	}
+	pub, err := RecoverPubkey(testHash, testR, highS, 1, false)
+	if err != nil {
+		t.Fatalf("non-strict RecoverPubkey() error = %v", err)
+	}
+	if got := hex.EncodeToString(pub); got != testPubkey {
+		t.Errorf("non-strict RecoverPubkey() = %s, want %s", got, testPubkey)
+	}
+}
+
+func TestRecoverPubkeyInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		r, s  *big.Int
+		recID byte
+		want  error
+	}{
+		{"recovery id 4", testR, testS, 4, ErrInvalidRecoveryID},
+		{"recovery id 255", testR, testS, 255, ErrInvalidRecoveryID},
+		{"zero r", big.NewInt(0), testS, 0, ErrInvalidSignature},
+		{"zero s", testR, big.NewInt(0), 0, ErrInvalidSignature},
+		{"r equal to N", N, testS, 0, ErrInvalidSignature},
+		{"s equal to N", testR, N, 0, ErrInvalidSignature},
+		// r + N exceeds the field size, so R cannot be reconstructed.
+		{"overflowing x", testR, testS, 2, ErrInvalidSignature},
+		// x = 5 is not on the curve: 5^3 + 7 = 132 has no square root mod p.
+		{"x not on curve", big.NewInt(5), testS, 0, ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := RecoverPubkey(testHash, tt.r, tt.s, tt.recID, true); !errors.Is(err, tt.want) {
+				t.Errorf("RecoverPubkey() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,14 @@
 package alchemy
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
 // Network represents a blockchain network supported by Alchemy.
 type Network string
 
@@ -99,253 +108,219 @@ func (n Network) NFTURL() string {
 	return "https://" + string(n) + ".g.alchemy.com/nft/v3"
 }
 
+// BeaconURL returns the Beacon API base URL for the network, or an empty
+// string if the network has no Beacon API.
+func (n Network) BeaconURL() string {
+	if !chainRegistry[n].Beacon {
+		return ""
+	}
+	return "https://" + string(n) + "beacon.g.alchemy.com/v2"
+}
+
 // String returns the network identifier string.
 func (n Network) String() string {
 	return string(n)
 }
 
-// ChainID returns the chain ID for the network.
-// Returns 0 if the network is unknown.
-func (n Network) ChainID() uint64 {
-	switch n {
+// ChainInfo holds static properties of a network.
+type ChainInfo struct {
+	// ChainID is the EIP-155 chain ID.
+	ChainID uint64
+	// NativeCurrency is the native currency symbol.
+	NativeCurrency string
+	// NativeDecimals is the number of decimals of the native currency.
+	NativeDecimals int
+	// ExplorerURL is the base URL of the network's block explorer.
+	ExplorerURL string
+	// Mainnet marks a production network.
+	Mainnet bool
+	// Testnet marks a test network.
+	Testnet bool
+	// L2 marks a Layer 2 network.
+	L2 bool
+	// Ethereum marks an Ethereum (L1) network.
+	Ethereum bool
+	// Beacon marks a network whose consensus layer Alchemy serves through
+	// the Beacon API.
+	Beacon bool
+}
+
+// chainRegistry holds the static properties of every supported network.
+// Adding a network only requires a new entry here.
+var chainRegistry = map[Network]ChainInfo{
 	// Ethereum
-	case EthMainnet:
-		return 1
-	case EthSepolia:
-		return 11155111
-	case EthHolesky:
-		return 17000
-	case EthHoodi:
-		return 560048
+	EthMainnet: {ChainID: 1, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://etherscan.io", Mainnet: true, Ethereum: true, Beacon: true},
+	EthSepolia: {ChainID: 11155111, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia.etherscan.io", Testnet: true, Ethereum: true, Beacon: true},
+	EthHolesky: {ChainID: 17000, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://holesky.etherscan.io", Testnet: true, Ethereum: true, Beacon: true},
+	EthHoodi:   {ChainID: 560048, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://hoodi.etherscan.io", Testnet: true, Ethereum: true, Beacon: true},
 
 	// Polygon
-	case PolygonMainnet:
-		return 137
-	case PolygonAmoy:
-		return 80002
+	PolygonMainnet: {ChainID: 137, NativeCurrency: "MATIC", NativeDecimals: 18, ExplorerURL: "https://polygonscan.com", Mainnet: true},
+	PolygonAmoy:    {ChainID: 80002, NativeCurrency: "MATIC", NativeDecimals: 18, ExplorerURL: "https://amoy.polygonscan.com", Testnet: true},
 
 	// Arbitrum
-	case ArbitrumMainnet:
-		return 42161
-	case ArbitrumSepolia:
-		return 421614
-	case ArbitrumNova:
-		return 42170
+	ArbitrumMainnet: {ChainID: 42161, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://arbiscan.io", Mainnet: true, L2: true},
+	ArbitrumSepolia: {ChainID: 421614, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia.arbiscan.io", Testnet: true, L2: true},
+	ArbitrumNova:    {ChainID: 42170, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://nova.arbiscan.io", Mainnet: true, L2: true},
 
 	// Optimism
-	case OptimismMainnet:
-		return 10
-	case OptimismSepolia:
-		return 11155420
+	OptimismMainnet: {ChainID: 10, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://optimistic.etherscan.io", Mainnet: true, L2: true},
+	OptimismSepolia: {ChainID: 11155420, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia-optimism.etherscan.io", Testnet: true, L2: true},
 
 	// Base
-	case BaseMainnet:
-		return 8453
-	case BaseSepolia:
-		return 84532
+	BaseMainnet: {ChainID: 8453, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://basescan.org", Mainnet: true, L2: true},
+	BaseSepolia: {ChainID: 84532, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia.basescan.org", Testnet: true, L2: true},
 
 	// zkSync
-	case ZkSyncMainnet:
-		return 324
-	case ZkSyncSepolia:
-		return 300
+	ZkSyncMainnet: {ChainID: 324, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://explorer.zksync.io", Mainnet: true, L2: true},
+	ZkSyncSepolia: {ChainID: 300, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia.explorer.zksync.io", Testnet: true, L2: true},
 
 	// Polygon zkEVM
-	case PolygonZkEvmMainnet:
-		return 1101
-	case PolygonZkEvmCardona:
-		return 2442
+	PolygonZkEvmMainnet: {ChainID: 1101, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://zkevm.polygonscan.com", Mainnet: true, L2: true},
+	PolygonZkEvmCardona: {ChainID: 2442, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://cardona-zkevm.polygonscan.com", Testnet: true, L2: true},
 
 	// Linea
-	case LineaMainnet:
-		return 59144
-	case LineaSepolia:
-		return 59141
+	LineaMainnet: {ChainID: 59144, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://lineascan.build", Mainnet: true, L2: true},
+	LineaSepolia: {ChainID: 59141, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia.lineascan.build", Testnet: true, L2: true},
 
 	// Scroll
-	case ScrollMainnet:
-		return 534352
-	case ScrollSepolia:
-		return 534351
+	ScrollMainnet: {ChainID: 534352, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://scrollscan.com", Mainnet: true, L2: true},
+	ScrollSepolia: {ChainID: 534351, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia.scrollscan.com", Testnet: true, L2: true},
 
 	// Blast
-	case BlastMainnet:
-		return 81457
-	case BlastSepolia:
-		return 168587773
+	BlastMainnet: {ChainID: 81457, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://blastscan.io", Mainnet: true, L2: true},
+	BlastSepolia: {ChainID: 168587773, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia.blastscan.io", Testnet: true, L2: true},
 
 	// Avalanche
-	case AvalancheMainnet:
-		return 43114
-	case AvalancheFuji:
-		return 43113
+	AvalancheMainnet: {ChainID: 43114, NativeCurrency: "AVAX", NativeDecimals: 18, ExplorerURL: "https://snowtrace.io", Mainnet: true},
+	AvalancheFuji:    {ChainID: 43113, NativeCurrency: "AVAX", NativeDecimals: 18, ExplorerURL: "https://testnet.snowtrace.io", Testnet: true},
 
 	// BNB
-	case BNBMainnet:
-		return 56
-	case BNBTestnet:
-		return 97
+	BNBMainnet: {ChainID: 56, NativeCurrency: "BNB", NativeDecimals: 18, ExplorerURL: "https://bscscan.com", Mainnet: true},
+	BNBTestnet: {ChainID: 97, NativeCurrency: "BNB", NativeDecimals: 18, ExplorerURL: "https://testnet.bscscan.com", Testnet: true},
 
 	// Fantom
-	case FantomMainnet:
-		return 250
-	case FantomTestnet:
-		return 4002
+	FantomMainnet: {ChainID: 250, NativeCurrency: "FTM", NativeDecimals: 18, ExplorerURL: "https://ftmscan.com", Mainnet: true},
+	FantomTestnet: {ChainID: 4002, NativeCurrency: "FTM", NativeDecimals: 18, ExplorerURL: "https://testnet.ftmscan.com", Testnet: true},
 
 	// Gnosis
-	case GnosisMainnet:
-		return 100
-	case GnosisChiado:
-		return 10200
+	GnosisMainnet: {ChainID: 100, NativeCurrency: "xDAI", NativeDecimals: 18, ExplorerURL: "https://gnosisscan.io", Mainnet: true},
+	GnosisChiado:  {ChainID: 10200, NativeCurrency: "xDAI", NativeDecimals: 18, ExplorerURL: "https://gnosis-chiado.blockscout.com", Testnet: true},
 
 	// Celo
-	case CeloMainnet:
-		return 42220
-	case CeloAlfajores:
-		return 44787
+	CeloMainnet:   {ChainID: 42220, NativeCurrency: "CELO", NativeDecimals: 18, ExplorerURL: "https://celoscan.io", Mainnet: true},
+	CeloAlfajores: {ChainID: 44787, NativeCurrency: "CELO", NativeDecimals: 18, ExplorerURL: "https://alfajores.celoscan.io", Testnet: true},
 
 	// Mantle
-	case MantleMainnet:
-		return 5000
-	case MantleSepolia:
-		return 5003
+	MantleMainnet: {ChainID: 5000, NativeCurrency: "MNT", NativeDecimals: 18, ExplorerURL: "https://mantlescan.xyz", Mainnet: true, L2: true},
+	MantleSepolia: {ChainID: 5003, NativeCurrency: "MNT", NativeDecimals: 18, ExplorerURL: "https://sepolia.mantlescan.xyz", Testnet: true, L2: true},
 
 	// World Chain
-	case WorldChainMainnet:
-		return 480
-	case WorldChainSepolia:
-		return 4801
+	WorldChainMainnet: {ChainID: 480, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://worldscan.org", Mainnet: true},
+	WorldChainSepolia: {ChainID: 4801, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia.worldscan.org", Testnet: true},
 
 	// Zora
-	case ZoraMainnet:
-		return 7777777
-	case ZoraSepolia:
-		return 999999999
+	ZoraMainnet: {ChainID: 7777777, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://explorer.zora.energy", Mainnet: true, L2: true},
+	ZoraSepolia: {ChainID: 999999999, NativeCurrency: "ETH", NativeDecimals: 18, ExplorerURL: "https://sepolia.explorer.zora.energy", Testnet: true, L2: true},
 
 	// Berachain
-	case BerachainBartio:
-		return 80084
+	BerachainBartio: {ChainID: 80084, NativeCurrency: "BERA", NativeDecimals: 18, ExplorerURL: "https://bartio.beratrail.io", Testnet: true},
 
 	// Flow
-	case FlowMainnet:
-		return 747
-	case FlowTestnet:
-		return 545
+	FlowMainnet: {ChainID: 747, NativeCurrency: "FLOW", NativeDecimals: 18, ExplorerURL: "https://evm.flowscan.io", Mainnet: true},
+	FlowTestnet: {ChainID: 545, NativeCurrency: "FLOW", NativeDecimals: 18, ExplorerURL: "https://evm-testnet.flowscan.io", Testnet: true},
+}
+
+// Info returns the static properties of the network.
+// Returns false if the network is unknown.
+func (n Network) Info() (ChainInfo, bool) {
+	info, ok := chainRegistry[n]
+	return info, ok
+}
+
+// ChainID returns the chain ID for the network.
+// Returns 0 if the network is unknown.
+func (n Network) ChainID() uint64 {
+	return chainRegistry[n].ChainID
+}
 
-	default:
-		return 0
+// RPCURL returns the JSON-RPC endpoint for the network with the given API key.
+func (n Network) RPCURL(apiKey string) string {
+	return n.BaseURL() + "/" + apiKey
+}
+
+// ExplorerURL returns the base URL of the network's block explorer.
+// Returns an empty string if the network is unknown.
+func (n Network) ExplorerURL() string {
+	return chainRegistry[n].ExplorerURL
+}
+
+// ExplorerTxURL returns the block explorer URL for a transaction.
+// Returns an empty string if the network has no known explorer.
+func (n Network) ExplorerTxURL(hash types.Hash) string {
+	explorer := n.ExplorerURL()
+	if explorer == "" {
+		return ""
+	}
+	return explorer + "/tx/" + hash.String()
+}
+
+// ExplorerAddressURL returns the block explorer URL for an address.
+// Returns an empty string if the network has no known explorer.
+func (n Network) ExplorerAddressURL(address types.Address) string {
+	explorer := n.ExplorerURL()
+	if explorer == "" {
+		return ""
 	}
+	return explorer + "/address/" + address.String()
+}
+
+// NativeDecimals returns the number of decimals of the native currency.
+// Returns 18 if the network is unknown.
+func (n Network) NativeDecimals() int {
+	if info, ok := chainRegistry[n]; ok {
+		return info.NativeDecimals
+	}
+	return 18
 }
 
 // IsMainnet returns true if this is a mainnet network.
 func (n Network) IsMainnet() bool {
-	switch n {
-	case EthMainnet, PolygonMainnet, ArbitrumMainnet, ArbitrumNova,
-		OptimismMainnet, BaseMainnet, ZkSyncMainnet, PolygonZkEvmMainnet,
-		LineaMainnet, ScrollMainnet, BlastMainnet, AvalancheMainnet,
-		BNBMainnet, FantomMainnet, GnosisMainnet, CeloMainnet,
-		MantleMainnet, WorldChainMainnet, ZoraMainnet, FlowMainnet:
-		return true
-	default:
-		return false
-	}
+	return chainRegistry[n].Mainnet
 }
 
 // IsTestnet returns true if this is a testnet network.
 func (n Network) IsTestnet() bool {
-	return !n.IsMainnet()
+	return chainRegistry[n].Testnet
 }
 
 // IsEthereum returns true if this is an Ethereum network.
 func (n Network) IsEthereum() bool {
-	switch n {
-	case EthMainnet, EthSepolia, EthHolesky, EthHoodi:
-		return true
-	default:
-		return false
-	}
+	return chainRegistry[n].Ethereum
 }
 
 // IsL2 returns true if this is a Layer 2 network.
 func (n Network) IsL2() bool {
-	switch n {
-	case ArbitrumMainnet, ArbitrumSepolia, ArbitrumNova,
-		OptimismMainnet, OptimismSepolia,
-		BaseMainnet, BaseSepolia,
-		ZkSyncMainnet, ZkSyncSepolia,
-		PolygonZkEvmMainnet, PolygonZkEvmCardona,
-		LineaMainnet, LineaSepolia,
-		ScrollMainnet, ScrollSepolia,
-		BlastMainnet, BlastSepolia,
-		MantleMainnet, MantleSepolia,
-		ZoraMainnet, ZoraSepolia:
-		return true
-	default:
-		return false
-	}
+	return chainRegistry[n].L2
 }
 
 // NativeCurrency returns the native currency symbol for the network.
+// Returns "ETH" if the network is unknown.
 func (n Network) NativeCurrency() string {
-	switch n {
-	case PolygonMainnet, PolygonAmoy:
-		return "MATIC"
-	case AvalancheMainnet, AvalancheFuji:
-		return "AVAX"
-	case BNBMainnet, BNBTestnet:
-		return "BNB"
-	case FantomMainnet, FantomTestnet:
-		return "FTM"
-	case GnosisMainnet, GnosisChiado:
-		return "xDAI"
-	case CeloMainnet, CeloAlfajores:
-		return "CELO"
-	case MantleMainnet, MantleSepolia:
-		return "MNT"
-	case FlowMainnet, FlowTestnet:
-		return "FLOW"
-	case BerachainBartio:
-		return "BERA"
-	default:
-		return "ETH"
+	if info, ok := chainRegistry[n]; ok {
+		return info.NativeCurrency
 	}
+	return "ETH"
 }
 
-// AllNetworks returns a list of all supported networks.
+// AllNetworks returns all supported networks, sorted by identifier.
 func AllNetworks() []Network {
-	return []Network{
-		// Ethereum
-		EthMainnet, EthSepolia, EthHolesky, EthHoodi,
-		// Polygon
-		PolygonMainnet, PolygonAmoy,
-		// Arbitrum
-		ArbitrumMainnet, ArbitrumSepolia, ArbitrumNova,
-		// Optimism
-		OptimismMainnet, OptimismSepolia,
-		// Base
-		BaseMainnet, BaseSepolia,
-		// zkSync
-		ZkSyncMainnet, ZkSyncSepolia,
-		// Polygon zkEVM
-		PolygonZkEvmMainnet, PolygonZkEvmCardona,
-		// Linea
-		LineaMainnet, LineaSepolia,
-		// Scroll
-		ScrollMainnet, ScrollSepolia,
-		// Blast
-		BlastMainnet, BlastSepolia,
-		// Others
-		AvalancheMainnet, AvalancheFuji,
-		BNBMainnet, BNBTestnet,
-		FantomMainnet, FantomTestnet,
-		GnosisMainnet, GnosisChiado,
-		CeloMainnet, CeloAlfajores,
-		MantleMainnet, MantleSepolia,
-		WorldChainMainnet, WorldChainSepolia,
-		ZoraMainnet, ZoraSepolia,
-		BerachainBartio,
-		FlowMainnet, FlowTestnet,
+	networks := make([]Network, 0, len(chainRegistry))
+	for n := range chainRegistry {
+		networks = append(networks, n)
 	}
+	sort.Slice(networks, func(i, j int) bool { return networks[i] < networks[j] })
+	return networks
 }
 
 // MainnetNetworks returns a list of all mainnet networks.
@@ -358,3 +333,49 @@ func MainnetNetworks() []Network {
 	}
 	return networks
 }
+
+// networkAliases maps common alternative names to networks.
+var networkAliases = map[string]Network{
+	"mainnet":   EthMainnet,
+	"ethereum":  EthMainnet,
+	"eth":       EthMainnet,
+	"sepolia":   EthSepolia,
+	"holesky":   EthHolesky,
+	"hoodi":     EthHoodi,
+	"polygon":   PolygonMainnet,
+	"matic":     PolygonMainnet,
+	"amoy":      PolygonAmoy,
+	"arbitrum":  ArbitrumMainnet,
+	"arb":       ArbitrumMainnet,
+	"optimism":  OptimismMainnet,
+	"op":        OptimismMainnet,
+	"base":      BaseMainnet,
+	"zksync":    ZkSyncMainnet,
+	"linea":     LineaMainnet,
+	"scroll":    ScrollMainnet,
+	"blast":     BlastMainnet,
+	"avalanche": AvalancheMainnet,
+	"avax":      AvalancheMainnet,
+	"bnb":       BNBMainnet,
+	"bsc":       BNBMainnet,
+	"fantom":    FantomMainnet,
+	"gnosis":    GnosisMainnet,
+	"celo":      CeloMainnet,
+	"mantle":    MantleMainnet,
+	"zora":      ZoraMainnet,
+}
+
+// ParseNetwork parses a network name, accepting the canonical slugs
+// (e.g. "eth-mainnet") and common aliases (e.g. "mainnet", "polygon").
+// Matching is case-insensitive and ignores surrounding whitespace.
+// Returns errors.ErrNetworkNotFound for unknown names.
+func ParseNetwork(s string) (Network, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if _, ok := chainRegistry[Network(name)]; ok {
+		return Network(name), nil
+	}
+	if n, ok := networkAliases[name]; ok {
+		return n, nil
+	}
+	return "", fmt.Errorf("%w: %q", errors.ErrNetworkNotFound, s)
+}
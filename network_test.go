@@ -0,0 +1,64 @@
+package alchemy
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestAllNetworksFromRegistry(t *testing.T) {
+	networks := AllNetworks()
+	if len(networks) != len(chainRegistry) {
+		t.Errorf("AllNetworks() has %d networks, want %d", len(networks), len(chainRegistry))
+	}
+	if !sort.SliceIsSorted(networks, func(i, j int) bool { return networks[i] < networks[j] }) {
+		t.Errorf("AllNetworks() is not sorted: %v", networks)
+	}
+
+	for _, n := range networks {
+		if n.IsMainnet() == n.IsTestnet() {
+			t.Errorf("%s: IsMainnet() = IsTestnet() = %v, want exactly one", n, n.IsMainnet())
+		}
+	}
+}
+
+func TestNetworkPredicates(t *testing.T) {
+	tests := []struct {
+		network                 Network
+		mainnet, l2, isEthereum bool
+	}{
+		{EthMainnet, true, false, true},
+		{EthSepolia, false, false, true},
+		{ArbitrumNova, true, true, false},
+		{BaseSepolia, false, true, false},
+		{PolygonMainnet, true, false, false},
+		{BerachainBartio, false, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.network.IsMainnet(); got != tt.mainnet {
+			t.Errorf("%s.IsMainnet() = %v, want %v", tt.network, got, tt.mainnet)
+		}
+		if got := tt.network.IsL2(); got != tt.l2 {
+			t.Errorf("%s.IsL2() = %v, want %v", tt.network, got, tt.l2)
+		}
+		if got := tt.network.IsEthereum(); got != tt.isEthereum {
+			t.Errorf("%s.IsEthereum() = %v, want %v", tt.network, got, tt.isEthereum)
+		}
+	}
+
+	unknown := Network("not-a-network")
+	if unknown.IsMainnet() || unknown.IsTestnet() || unknown.IsL2() {
+		t.Errorf("unknown network has flags set")
+	}
+}
+
+func TestNetworkBeaconURL(t *testing.T) {
+	if got, want := EthMainnet.BeaconURL(), "https://eth-mainnetbeacon.g.alchemy.com/v2"; got != want {
+		t.Errorf("EthMainnet.BeaconURL() = %q, want %q", got, want)
+	}
+	for _, n := range []Network{ArbitrumMainnet, BaseMainnet, PolygonMainnet, Network("not-a-network")} {
+		if got := n.BeaconURL(); got != "" {
+			t.Errorf("%s.BeaconURL() = %q, want none", n, got)
+		}
+	}
+}
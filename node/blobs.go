@@ -0,0 +1,129 @@
+package node
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// blobCommitmentVersionKZG is the version byte of KZG blob versioned hashes.
+const blobCommitmentVersionKZG = 0x01
+
+// BlobSidecar is an EIP-4844 blob together with its KZG commitment and proof.
+type BlobSidecar struct {
+	// Index is the index of the blob in the block.
+	Index uint64
+	// Blob is the blob data (131072 bytes).
+	Blob types.Data
+	// KZGCommitment is the KZG commitment to the blob.
+	KZGCommitment types.Data
+	// KZGProof is the KZG proof of the blob against the commitment.
+	KZGProof types.Data
+}
+
+// VersionedHash returns the blob's versioned hash, as referenced by
+// Transaction.BlobVersionedHashes.
+func (s *BlobSidecar) VersionedHash() types.Hash {
+	h := sha256.Sum256(s.KZGCommitment.Bytes())
+	h[0] = blobCommitmentVersionKZG
+	return types.Hash(hex.Encode(h[:]))
+}
+
+// beaconBlobSidecar is a blob sidecar as returned by the Beacon API. The
+// fields the SDK does not expose are declared for strict decoding.
+type beaconBlobSidecar struct {
+	Index                       string          `json:"index"`
+	Blob                        types.Data      `json:"blob"`
+	KZGCommitment               types.Data      `json:"kzg_commitment"`
+	KZGProof                    types.Data      `json:"kzg_proof"`
+	SignedBlockHeader           json.RawMessage `json:"signed_block_header,omitempty"`
+	KZGCommitmentInclusionProof []types.Data    `json:"kzg_commitment_inclusion_proof,omitempty"`
+}
+
+// beaconBlobSidecarsResponse is the Beacon API blob_sidecars response.
+type beaconBlobSidecarsResponse struct {
+	Data                []beaconBlobSidecar `json:"data"`
+	ExecutionOptimistic *bool               `json:"execution_optimistic,omitempty"`
+	Finalized           *bool               `json:"finalized,omitempty"`
+}
+
+// GetBlobSidecars returns the blob sidecars of a block via the Beacon API
+// (/eth/v1/beacon/blob_sidecars), in blob order. The Beacon API is served
+// from a separate endpoint; see WithBeaconURL.
+// BlockLatest, BlockFinalized and BlockEarliest map to the beacon "head",
+// "finalized" and "genesis" blocks. Numbered blocks are resolved through the
+// parent beacon block root of the following block, so the most recent block
+// must be requested with BlockLatest. Returns an empty slice for blocks
+// without blobs; sidecars are pruned by beacon nodes after about 18 days.
+func (c *Client) GetBlobSidecars(ctx context.Context, block BlockNumberOrTag) ([]BlobSidecar, error) {
+	if c.beaconURL == "" {
+		return nil, fmt.Errorf("%w: no Beacon API URL configured", sdkerrors.ErrInvalidParameter)
+	}
+
+	blockID, err := c.beaconBlockID(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := c.rpc.HTTPClient()
+	body, err := httpClient.GetURL(ctx, c.beaconEndpoint("eth/v1/beacon/blob_sidecars/"+blockID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp beaconBlobSidecarsResponse
+	if err := httpClient.Unmarshal(body, &resp); err != nil {
+		return nil, sdkerrors.Wrap(err, "UNMARSHAL_ERROR", "failed to unmarshal blob sidecars")
+	}
+
+	sidecars := make([]BlobSidecar, len(resp.Data))
+	for i, s := range resp.Data {
+		index, err := strconv.ParseUint(s.Index, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid blob index %q", sdkerrors.ErrInvalidResponse, s.Index)
+		}
+		sidecars[i] = BlobSidecar{
+			Index:         index,
+			Blob:          s.Blob,
+			KZGCommitment: s.KZGCommitment,
+			KZGProof:      s.KZGProof,
+		}
+	}
+	return sidecars, nil
+}
+
+// beaconBlockID returns the Beacon API block identifier of an execution block.
+func (c *Client) beaconBlockID(ctx context.Context, block BlockNumberOrTag) (string, error) {
+	switch block {
+	case "", BlockLatest:
+		return "head", nil
+	case BlockFinalized:
+		return "finalized", nil
+	case BlockEarliest:
+		return "genesis", nil
+	case BlockPending, BlockSafe:
+		return "", fmt.Errorf("%w: blob sidecars are not available for %q blocks", sdkerrors.ErrInvalidParameter, block)
+	}
+
+	n, err := hex.DecodeUint64(block.String())
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid block %q", sdkerrors.ErrInvalidParameter, block)
+	}
+	next, err := c.GetBlockByNumber(ctx, BlockNumber(n+1), false)
+	if err != nil {
+		return "", err
+	}
+	if next == nil {
+		return "", fmt.Errorf("block %d has no child yet; use BlockLatest for the head block", n)
+	}
+	if next.ParentBeaconBlockRoot == nil {
+		return "", fmt.Errorf("%w: block %d predates the beacon block root (EIP-4788)", sdkerrors.ErrInvalidParameter, n)
+	}
+	return next.ParentBeaconBlockRoot.String(), nil
+}
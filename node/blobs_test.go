@@ -0,0 +1,75 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+// newBlobTestClient creates a client whose JSON-RPC requests fail and whose
+// Beacon API requests go to a server replying with body.
+func newBlobTestClient(t *testing.T, body string) (*Client, *string) {
+	t.Helper()
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected JSON-RPC request to %s", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(rpc.Close)
+
+	var path string
+	beacon := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(beacon.Close)
+
+	httpClient := client.NewHTTPClient(client.HTTPClientConfig{BaseURL: rpc.URL + "/v2/key", APIKey: "key"})
+	c := NewClient(client.NewJSONRPCClient(httpClient)).WithBeaconURL(beacon.URL+"/v2/", "key")
+	return c, &path
+}
+
+func TestGetBlobSidecars(t *testing.T) {
+	c, path := newBlobTestClient(t, `{"data":[{"index":"1","blob":"0x01","kzg_commitment":"0x02","kzg_proof":"0x03",`+
+		`"kzg_commitment_inclusion_proof":["0x04"],"signed_block_header":{}}],"execution_optimistic":false,"finalized":true}`)
+
+	sidecars, err := c.GetBlobSidecars(context.Background(), BlockLatest)
+	if err != nil {
+		t.Fatalf("GetBlobSidecars() error = %v", err)
+	}
+	if want := "/v2/key/eth/v1/beacon/blob_sidecars/head"; *path != want {
+		t.Errorf("requested %q, want %q", *path, want)
+	}
+	if len(sidecars) != 1 || sidecars[0].Index != 1 || sidecars[0].KZGCommitment.String() != "0x02" {
+		t.Errorf("GetBlobSidecars() = %+v", sidecars)
+	}
+}
+
+func TestGetBlobSidecarsErrors(t *testing.T) {
+	t.Run("no beacon URL", func(t *testing.T) {
+		c := NewClient(client.NewJSONRPCClient(client.NewHTTPClient(client.HTTPClientConfig{})))
+		if _, err := c.GetBlobSidecars(context.Background(), BlockLatest); !errors.Is(err, sdkerrors.ErrInvalidParameter) {
+			t.Errorf("GetBlobSidecars() error = %v, want ErrInvalidParameter", err)
+		}
+	})
+
+	t.Run("malformed response", func(t *testing.T) {
+		c, _ := newBlobTestClient(t, `{"data":`)
+		_, err := c.GetBlobSidecars(context.Background(), BlockFinalized)
+		var sdkErr sdkerrors.Error
+		if !errors.As(err, &sdkErr) || sdkErr.Code() != "UNMARSHAL_ERROR" {
+			t.Errorf("GetBlobSidecars() error = %v, want UNMARSHAL_ERROR", err)
+		}
+	})
+
+	t.Run("invalid index", func(t *testing.T) {
+		c, _ := newBlobTestClient(t, `{"data":[{"index":"x","blob":"0x","kzg_commitment":"0x","kzg_proof":"0x"}]}`)
+		if _, err := c.GetBlobSidecars(context.Background(), BlockLatest); !errors.Is(err, sdkerrors.ErrInvalidResponse) {
+			t.Errorf("GetBlobSidecars() error = %v, want ErrInvalidResponse", err)
+		}
+	})
+}
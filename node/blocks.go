@@ -0,0 +1,148 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// blockRangeBatchSize is the number of blocks requested per JSON-RPC batch.
+const blockRangeBatchSize = 50
+
+// MaxBlockRange is the maximum number of blocks GetBlockRange fetches in
+// one call. Larger ranges must be split by the caller.
+const MaxBlockRange = 10000
+
+// BlockRangeError reports the blocks that could not be fetched by GetBlockRange.
+type BlockRangeError struct {
+	// Failures maps each failed block number to its error.
+	Failures map[uint64]error
+}
+
+// Error implements the error interface.
+func (e *BlockRangeError) Error() string {
+	numbers := e.BlockNumbers()
+	parts := make([]string, 0, len(numbers))
+	for _, n := range numbers {
+		parts = append(parts, fmt.Sprintf("%d: %v", n, e.Failures[n]))
+	}
+	return fmt.Sprintf("failed to fetch %d block(s): %s", len(numbers), strings.Join(parts, "; "))
+}
+
+// BlockNumbers returns the failed block numbers in ascending order.
+func (e *BlockRangeError) BlockNumbers() []uint64 {
+	numbers := make([]uint64, 0, len(e.Failures))
+	for n := range e.Failures {
+		numbers = append(numbers, n)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	return numbers
+}
+
+// GetBlockRange fetches blocks from through to (inclusive) using JSON-RPC
+// batches, running at most concurrency batches at a time (minimum 1).
+// The returned slice is ordered by block number and has one entry per block.
+// Blocks that could not be fetched are left nil and reported in a
+// *BlockRangeError; the blocks that succeeded are still returned.
+// Ranges of more than MaxBlockRange blocks are rejected with ErrInvalidParameter.
+func (c *Client) GetBlockRange(ctx context.Context, from, to uint64, fullTx bool, concurrency int) ([]*types.Block, error) {
+	if to < from {
+		return nil, fmt.Errorf("%w: from block %d is greater than to block %d", sdkerrors.ErrInvalidParameter, from, to)
+	}
+	if to-from >= MaxBlockRange {
+		return nil, fmt.Errorf("%w: block range %d-%d exceeds %d blocks", sdkerrors.ErrInvalidParameter, from, to, MaxBlockRange)
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	count := to - from + 1
+	blocks := make([]*types.Block, count)
+	failures := make(map[uint64]error)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for start := uint64(0); start < count; start += blockRangeBatchSize {
+		end := start + blockRangeBatchSize
+		if end > count {
+			end = count
+		}
+
+		select {
+		case <-ctx.Done():
+			err := client.ContextError(ctx, ctx.Err())
+			mu.Lock()
+			for i := start; i < count; i++ {
+				failures[from+i] = err
+			}
+			mu.Unlock()
+			wg.Wait()
+			return blocks, &BlockRangeError{Failures: failures}
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(start, end uint64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			errs := c.fetchBlockBatch(ctx, from+start, blocks[start:end], fullTx)
+
+			mu.Lock()
+			for n, err := range errs {
+				failures[n] = err
+			}
+			mu.Unlock()
+		}(start, end)
+	}
+
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return blocks, &BlockRangeError{Failures: failures}
+	}
+	return blocks, nil
+}
+
+// fetchBlockBatch fetches len(dst) consecutive blocks starting at first into
+// dst in a single batch call and returns the errors keyed by block number.
+func (c *Client) fetchBlockBatch(ctx context.Context, first uint64, dst []*types.Block, fullTx bool) map[uint64]error {
+	calls := make([]client.BatchCall, len(dst))
+	for i := range dst {
+		calls[i] = client.BatchCall{
+			Method: "eth_getBlockByNumber",
+			Params: []interface{}{BlockNumber(first + uint64(i)).String(), fullTx},
+			Result: &dst[i],
+		}
+	}
+
+	errs := make(map[uint64]error)
+
+	results, err := c.rpc.BatchCall(ctx, calls)
+	if err != nil {
+		for i := range dst {
+			errs[first+uint64(i)] = err
+		}
+		return errs
+	}
+
+	for i := range dst {
+		n := first + uint64(i)
+		switch {
+		case results[i].Error != nil:
+			errs[n] = results[i].Error
+			dst[i] = nil
+		case dst[i] == nil:
+			errs[n] = fmt.Errorf("block %d not found", n)
+		}
+	}
+	return errs
+}
@@ -2,12 +2,18 @@
 package node
 
 import (
+	"strings"
+
 	"github.com/ABT-Tech-Limited/alchemy-go/client"
 )
 
 // Client is the Node API client for making JSON-RPC calls.
+// It is safe for concurrent use by multiple goroutines once configured;
+// WithBeaconURL must be called before the client is shared.
 type Client struct {
-	rpc *client.JSONRPCClient
+	rpc       *client.JSONRPCClient
+	beaconURL string
+	apiKey    string
 }
 
 // NewClient creates a new Node API client.
@@ -21,3 +27,26 @@ func NewClient(rpc *client.JSONRPCClient) *Client {
 func (c *Client) RPC() *client.JSONRPCClient {
 	return c.rpc
 }
+
+// WithBeaconURL sets the Beacon API base URL used by GetBlobSidecars and
+// returns the client. Requests are sent to beaconURL/apiKey/eth/v1/...; pass
+// an empty apiKey if beaconURL already includes the key.
+func (c *Client) WithBeaconURL(beaconURL, apiKey string) *Client {
+	c.beaconURL = strings.TrimSuffix(beaconURL, "/")
+	c.apiKey = apiKey
+	return c
+}
+
+// BeaconURL returns the Beacon API base URL, without the API key.
+func (c *Client) BeaconURL() string {
+	return c.beaconURL
+}
+
+// beaconEndpoint builds the Beacon API URL for the given path.
+func (c *Client) beaconEndpoint(path string) string {
+	url := c.beaconURL
+	if c.apiKey != "" {
+		url = url + "/" + c.apiKey
+	}
+	return url + "/" + path
+}
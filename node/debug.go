@@ -0,0 +1,52 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// StorageEntry is a storage slot returned by debug_storageRangeAt.
+type StorageEntry struct {
+	// Key is the storage slot, or nil if the node has no preimage for the hashed key.
+	Key *types.Hash `json:"key"`
+	// Value is the slot value.
+	Value types.Hash `json:"value"`
+}
+
+// StorageRange is the result of debug_storageRangeAt.
+type StorageRange struct {
+	// Storage maps the Keccak-256 hash of each slot to its entry. The range
+	// covers hashed keys in ascending order from the requested start key.
+	Storage map[types.Hash]StorageEntry `json:"storage"`
+	// NextKey is the hashed key to continue from, or nil at the end of storage.
+	NextKey *types.Hash `json:"nextKey"`
+}
+
+// HasMore returns true if there are more storage slots after this range.
+func (r *StorageRange) HasMore() bool {
+	return r.NextKey != nil
+}
+
+// StorageRangeAt returns up to maxResults storage slots of contract, starting
+// at the hashed key startKey, as of after transaction txIndex in the block
+// (debug_storageRangeAt). Pass NextKey as startKey to continue.
+// Keys are the Keccak-256 hashes of the slots; use types.ZeroHash to start
+// at the beginning of storage.
+func (c *Client) StorageRangeAt(ctx context.Context, blockHash types.Hash, txIndex int, contract types.Address, startKey types.Hash, maxResults int) (*StorageRange, error) {
+	if txIndex < 0 {
+		return nil, fmt.Errorf("%w: txIndex must not be negative", sdkerrors.ErrInvalidParameter)
+	}
+	if maxResults <= 0 {
+		return nil, fmt.Errorf("%w: maxResults must be positive", sdkerrors.ErrInvalidParameter)
+	}
+
+	var result StorageRange
+	params := []interface{}{blockHash.String(), txIndex, contract.String(), startKey.String(), maxResults}
+	if err := c.rpc.Call(ctx, "debug_storageRangeAt", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -106,22 +106,26 @@ func (c *Client) GetTransactionCount(ctx context.Context, address types.Address,
 }
 
 // GetBlockByNumber returns a block by its number.
+// Returns (nil, nil) if the block does not exist.
 func (c *Client) GetBlockByNumber(ctx context.Context, number BlockNumberOrTag, fullTx bool) (*types.Block, error) {
 	if number == "" {
 		number = BlockLatest
 	}
 
 	var result types.Block
-	if err := c.rpc.Call(ctx, "eth_getBlockByNumber", []interface{}{number.String(), fullTx}, &result); err != nil {
+	found, err := c.rpc.CallOptional(ctx, "eth_getBlockByNumber", []interface{}{number.String(), fullTx}, &result)
+	if err != nil || !found {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // GetBlockByHash returns a block by its hash.
+// Returns (nil, nil) if the block does not exist.
 func (c *Client) GetBlockByHash(ctx context.Context, hash types.Hash, fullTx bool) (*types.Block, error) {
 	var result types.Block
-	if err := c.rpc.Call(ctx, "eth_getBlockByHash", []interface{}{hash.String(), fullTx}, &result); err != nil {
+	found, err := c.rpc.CallOptional(ctx, "eth_getBlockByHash", []interface{}{hash.String(), fullTx}, &result)
+	if err != nil || !found {
 		return nil, err
 	}
 	return &result, nil
@@ -150,40 +154,65 @@ func (c *Client) GetBlockTransactionCountByHash(ctx context.Context, hash types.
 }
 
 // GetTransactionByHash returns a transaction by its hash.
+// Returns (nil, nil) if the node does not know the transaction; a pending
+// transaction is returned with a nil BlockNumber (see Transaction.IsPending).
 func (c *Client) GetTransactionByHash(ctx context.Context, hash types.Hash) (*types.Transaction, error) {
 	var result types.Transaction
-	if err := c.rpc.Call(ctx, "eth_getTransactionByHash", []interface{}{hash.String()}, &result); err != nil {
+	found, err := c.rpc.CallOptional(ctx, "eth_getTransactionByHash", []interface{}{hash.String()}, &result)
+	if err != nil || !found {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetTransactionStatus reports whether a transaction is unknown, pending or mined.
+func (c *Client) GetTransactionStatus(ctx context.Context, hash types.Hash) (TransactionStatus, error) {
+	tx, err := c.GetTransactionByHash(ctx, hash)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case tx == nil:
+		return TransactionNotFound, nil
+	case tx.IsPending():
+		return TransactionPending, nil
+	default:
+		return TransactionMined, nil
+	}
+}
+
 // GetTransactionByBlockHashAndIndex returns a transaction by block hash and index.
+// Returns (nil, nil) if the block or index does not exist.
 func (c *Client) GetTransactionByBlockHashAndIndex(ctx context.Context, blockHash types.Hash, index uint64) (*types.Transaction, error) {
 	var result types.Transaction
-	if err := c.rpc.Call(ctx, "eth_getTransactionByBlockHashAndIndex", []interface{}{blockHash.String(), hex.EncodeUint64(index)}, &result); err != nil {
+	found, err := c.rpc.CallOptional(ctx, "eth_getTransactionByBlockHashAndIndex", []interface{}{blockHash.String(), hex.EncodeUint64(index)}, &result)
+	if err != nil || !found {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // GetTransactionByBlockNumberAndIndex returns a transaction by block number and index.
+// Returns (nil, nil) if the block or index does not exist.
 func (c *Client) GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNumber BlockNumberOrTag, index uint64) (*types.Transaction, error) {
 	if blockNumber == "" {
 		blockNumber = BlockLatest
 	}
 
 	var result types.Transaction
-	if err := c.rpc.Call(ctx, "eth_getTransactionByBlockNumberAndIndex", []interface{}{blockNumber.String(), hex.EncodeUint64(index)}, &result); err != nil {
+	found, err := c.rpc.CallOptional(ctx, "eth_getTransactionByBlockNumberAndIndex", []interface{}{blockNumber.String(), hex.EncodeUint64(index)}, &result)
+	if err != nil || !found {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // GetTransactionReceipt returns a transaction receipt by its hash.
+// Returns (nil, nil) if the transaction is unknown or still pending.
 func (c *Client) GetTransactionReceipt(ctx context.Context, hash types.Hash) (*types.TransactionReceipt, error) {
 	var result types.TransactionReceipt
-	if err := c.rpc.Call(ctx, "eth_getTransactionReceipt", []interface{}{hash.String()}, &result); err != nil {
+	found, err := c.rpc.CallOptional(ctx, "eth_getTransactionReceipt", []interface{}{hash.String()}, &result)
+	if err != nil || !found {
 		return nil, err
 	}
 	return &result, nil
@@ -220,6 +249,21 @@ func (c *Client) EstimateGas(ctx context.Context, msg *CallMsg) (uint64, error)
 	return result.Uint64(), nil
 }
 
+// CreateAccessList returns the access list the call would use (eth_createAccessList).
+// The list can be set on CallMsg.AccessList for eth_call and eth_estimateGas,
+// or included in an EIP-2930 transaction.
+func (c *Client) CreateAccessList(ctx context.Context, msg *CallMsg, block BlockNumberOrTag) (*AccessListResult, error) {
+	if block == "" {
+		block = BlockLatest
+	}
+
+	var result AccessListResult
+	if err := c.rpc.Call(ctx, "eth_createAccessList", []interface{}{msg, block.String()}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 // FeeHistory returns historical gas fee data.
 func (c *Client) FeeHistory(ctx context.Context, blockCount uint64, newestBlock BlockNumberOrTag, rewardPercentiles []float64) (*FeeHistory, error) {
 	if newestBlock == "" {
@@ -264,6 +308,26 @@ func (c *Client) GetBlockReceipts(ctx context.Context, block BlockNumberOrTag) (
 	return result, nil
 }
 
+// GetBlockLogs returns all logs emitted in a block, in block order, using a
+// single eth_getBlockReceipts call. Blocks without transactions or logs
+// yield an empty slice.
+func (c *Client) GetBlockLogs(ctx context.Context, block BlockNumberOrTag) ([]types.Log, error) {
+	receipts, err := c.GetBlockReceipts(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	n := 0
+	for i := range receipts {
+		n += len(receipts[i].Logs)
+	}
+	logs := make([]types.Log, 0, n)
+	for i := range receipts {
+		logs = append(logs, receipts[i].Logs...)
+	}
+	return logs, nil
+}
+
 // GetProof returns the account and storage values with Merkle proof.
 func (c *Client) GetProof(ctx context.Context, address types.Address, storageKeys []types.Hash, block BlockNumberOrTag) (*AccountProof, error) {
 	if block == "" {
@@ -0,0 +1,140 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// newResultTestClient creates a client whose requests are all answered with
+// result, and records the method of the last request.
+func newResultTestClient(t *testing.T, result json.RawMessage) (*Client, *string) {
+	t.Helper()
+	var method string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req client.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		method = req.Method
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient(client.NewJSONRPCClient(client.NewHTTPClient(client.HTTPClientConfig{BaseURL: srv.URL}))), &method
+}
+
+func TestOptionalGetters(t *testing.T) {
+	const hash = types.Hash("0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b")
+
+	// Each getter returns the hash of the object it decoded, or "" for nil.
+	tests := []struct {
+		method string
+		call   func(ctx context.Context, c *Client) (types.Hash, bool, error)
+	}{
+		{"eth_getBlockByNumber", func(ctx context.Context, c *Client) (types.Hash, bool, error) {
+			b, err := c.GetBlockByNumber(ctx, BlockNumber(1), false)
+			if b == nil {
+				return "", false, err
+			}
+			return b.Hash, true, err
+		}},
+		{"eth_getBlockByHash", func(ctx context.Context, c *Client) (types.Hash, bool, error) {
+			b, err := c.GetBlockByHash(ctx, hash, false)
+			if b == nil {
+				return "", false, err
+			}
+			return b.Hash, true, err
+		}},
+		{"eth_getTransactionByHash", func(ctx context.Context, c *Client) (types.Hash, bool, error) {
+			tx, err := c.GetTransactionByHash(ctx, hash)
+			if tx == nil {
+				return "", false, err
+			}
+			return tx.Hash, true, err
+		}},
+		{"eth_getTransactionByBlockHashAndIndex", func(ctx context.Context, c *Client) (types.Hash, bool, error) {
+			tx, err := c.GetTransactionByBlockHashAndIndex(ctx, hash, 0)
+			if tx == nil {
+				return "", false, err
+			}
+			return tx.Hash, true, err
+		}},
+		{"eth_getTransactionByBlockNumberAndIndex", func(ctx context.Context, c *Client) (types.Hash, bool, error) {
+			tx, err := c.GetTransactionByBlockNumberAndIndex(ctx, BlockNumber(1), 0)
+			if tx == nil {
+				return "", false, err
+			}
+			return tx.Hash, true, err
+		}},
+		{"eth_getTransactionReceipt", func(ctx context.Context, c *Client) (types.Hash, bool, error) {
+			r, err := c.GetTransactionReceipt(ctx, hash)
+			if r == nil {
+				return "", false, err
+			}
+			return r.TransactionHash, true, err
+		}},
+	}
+
+	found := json.RawMessage(`{"hash":"` + string(hash) + `","transactionHash":"` + string(hash) + `"}`)
+
+	for _, tt := range tests {
+		t.Run(tt.method+"/null", func(t *testing.T) {
+			c, method := newResultTestClient(t, json.RawMessage(`null`))
+			_, ok, err := tt.call(context.Background(), c)
+			if ok || err != nil {
+				t.Errorf("got ok = %v, error = %v; want (nil, nil)", ok, err)
+			}
+			if *method != tt.method {
+				t.Errorf("called %s, want %s", *method, tt.method)
+			}
+		})
+
+		t.Run(tt.method+"/found", func(t *testing.T) {
+			c, _ := newResultTestClient(t, found)
+			got, ok, err := tt.call(context.Background(), c)
+			if !ok || err != nil || got != hash {
+				t.Errorf("got %q, ok = %v, error = %v; want %s", got, ok, err, hash)
+			}
+		})
+	}
+}
+
+func TestGetBlockRangeLimits(t *testing.T) {
+	c, _ := newResultTestClient(t, json.RawMessage(`null`))
+	tests := []struct {
+		name     string
+		from, to uint64
+	}{
+		{"reversed", 10, 9},
+		{"too large", 0, MaxBlockRange},
+		{"overflow", 0, math.MaxUint64},
+	}
+	for _, tt := range tests {
+		if _, err := c.GetBlockRange(context.Background(), tt.from, tt.to, false, 1); !errors.Is(err, sdkerrors.ErrInvalidParameter) {
+			t.Errorf("%s: GetBlockRange(%d, %d) error = %v, want ErrInvalidParameter", tt.name, tt.from, tt.to, err)
+		}
+	}
+}
+
+func TestGetBlockRangeCanceled(t *testing.T) {
+	c, _ := newResultTestClient(t, json.RawMessage(`null`))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.GetBlockRange(ctx, 0, 9, false, 1)
+	var rangeErr *BlockRangeError
+	if !errors.As(err, &rangeErr) || len(rangeErr.Failures) != 10 {
+		t.Fatalf("GetBlockRange() error = %v, want a BlockRangeError for 10 blocks", err)
+	}
+	for n, err := range rangeErr.Failures {
+		if !errors.Is(err, sdkerrors.ErrContextCanceled) {
+			t.Errorf("block %d error = %v, want ErrContextCanceled", n, err)
+		}
+	}
+}
@@ -205,24 +205,33 @@ type CallMsg struct {
 	Value *big.Int `json:"value,omitempty"`
 	// Data is the input data.
 	Data []byte `json:"data,omitempty"`
+	// AccessList is the EIP-2930 access list, e.g. from CreateAccessList.
+	AccessList types.AccessList `json:"accessList,omitempty"`
+	// AuthorizationList is the EIP-7702 authorization list, to simulate
+	// set code (type 4) transactions.
+	AuthorizationList []types.SetCodeAuthorization `json:"authorizationList,omitempty"`
 }
 
 // MarshalJSON implements json.Marshaler.
 func (m CallMsg) MarshalJSON() ([]byte, error) {
 	type callMsgJSON struct {
-		From                 *types.Address `json:"from,omitempty"`
-		To                   *types.Address `json:"to,omitempty"`
-		Gas                  string         `json:"gas,omitempty"`
-		GasPrice             string         `json:"gasPrice,omitempty"`
-		MaxFeePerGas         string         `json:"maxFeePerGas,omitempty"`
-		MaxPriorityFeePerGas string         `json:"maxPriorityFeePerGas,omitempty"`
-		Value                string         `json:"value,omitempty"`
-		Data                 string         `json:"data,omitempty"`
+		From                 *types.Address               `json:"from,omitempty"`
+		To                   *types.Address               `json:"to,omitempty"`
+		Gas                  string                       `json:"gas,omitempty"`
+		GasPrice             string                       `json:"gasPrice,omitempty"`
+		MaxFeePerGas         string                       `json:"maxFeePerGas,omitempty"`
+		MaxPriorityFeePerGas string                       `json:"maxPriorityFeePerGas,omitempty"`
+		Value                string                       `json:"value,omitempty"`
+		Data                 string                       `json:"data,omitempty"`
+		AccessList           types.AccessList             `json:"accessList,omitempty"`
+		AuthorizationList    []types.SetCodeAuthorization `json:"authorizationList,omitempty"`
 	}
 
 	msg := callMsgJSON{
-		From: m.From,
-		To:   m.To,
+		From:              m.From,
+		To:                m.To,
+		AccessList:        m.AccessList,
+		AuthorizationList: m.AuthorizationList,
 	}
 
 	if m.Gas != nil {
@@ -280,7 +289,7 @@ func (s *SyncStatus) UnmarshalJSON(data []byte) error {
 	// First try to unmarshal as boolean (not syncing)
 	var syncing bool
 	if err := json.Unmarshal(data, &syncing); err == nil {
-		s.Syncing = syncing
+		*s = SyncStatus{Syncing: syncing}
 		return nil
 	}
 
@@ -304,6 +313,28 @@ func (s *SyncStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// IsSyncing returns true if the node is syncing. When false, the block
+// fields are not meaningful.
+func (s *SyncStatus) IsSyncing() bool {
+	return s.Syncing
+}
+
+// Progress returns the sync progress as CurrentBlock/HighestBlock, between
+// 0 and 1. A node that is not syncing reports 1; a syncing node that does
+// not yet know the highest block reports 0.
+func (s *SyncStatus) Progress() float64 {
+	if !s.Syncing {
+		return 1
+	}
+	if s.HighestBlock == 0 {
+		return 0
+	}
+	if s.CurrentBlock >= s.HighestBlock {
+		return 1
+	}
+	return float64(s.CurrentBlock) / float64(s.HighestBlock)
+}
+
 // TraceConfig represents configuration for trace methods.
 type TraceConfig struct {
 	// DisableStorage disables storage capture.
@@ -321,3 +352,26 @@ type TraceConfig struct {
 	// Timeout is the maximum time for tracing.
 	Timeout string `json:"timeout,omitempty"`
 }
+
+// TransactionStatus describes where a transaction is in its lifecycle.
+type TransactionStatus string
+
+// Transaction statuses.
+const (
+	// TransactionNotFound means the node does not know the transaction hash.
+	TransactionNotFound TransactionStatus = "not_found"
+	// TransactionPending means the transaction is in the mempool.
+	TransactionPending TransactionStatus = "pending"
+	// TransactionMined means the transaction is included in a block.
+	TransactionMined TransactionStatus = "mined"
+)
+
+// AccessListResult represents the result of eth_createAccessList.
+type AccessListResult struct {
+	// AccessList is the generated access list.
+	AccessList types.AccessList `json:"accessList"`
+	// GasUsed is the gas used by the call with the access list applied.
+	GasUsed types.Quantity `json:"gasUsed"`
+	// Error is set if the call reverted.
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,41 @@
+package node
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+func TestCallMsgMarshalAuthorizationList(t *testing.T) {
+	to := types.MustParseAddress("0x00000000000000000000000000000000000000aa")
+	msg := CallMsg{
+		To: &to,
+		AuthorizationList: []types.SetCodeAuthorization{{
+			ChainID: "0x1",
+			Address: types.MustParseAddress("0x00000000000000000000000000000000000000bb"),
+			Nonce:   "0x2",
+			YParity: "0x1",
+			R:       "0x3",
+			S:       "0x4",
+		}},
+	}
+
+	b, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `"authorizationList":[{"chainId":"0x1","address":"0x00000000000000000000000000000000000000bb","nonce":"0x2","yParity":"0x1","r":"0x3","s":"0x4"}]`
+	if !strings.Contains(string(b), want) {
+		t.Errorf("Marshal() = %s, want it to contain %s", b, want)
+	}
+
+	b, err = json.Marshal(CallMsg{To: &to})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if strings.Contains(string(b), "authorizationList") {
+		t.Errorf("Marshal() = %s, want authorizationList omitted", b)
+	}
+}
@@ -0,0 +1,46 @@
+package node
+
+import (
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// Event signature topics of the standard token events.
+const (
+	// TransferEventTopic is keccak256("Transfer(address,address,uint256)"),
+	// shared by ERC20 and ERC721.
+	TransferEventTopic types.Hash = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	// ApprovalEventTopic is keccak256("Approval(address,address,uint256)"),
+	// shared by ERC20 and ERC721.
+	ApprovalEventTopic types.Hash = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+)
+
+// TransferLogFilter returns a filter for Transfer events emitted by token,
+// optionally restricted to the indexed from and to addresses. A nil from or
+// to matches any address, and an empty token matches every contract.
+// Because ERC721 transfers share the signature, the filter matches both
+// ERC20 and ERC721 transfers.
+func TransferLogFilter(token types.Address, from, to *types.Address) *LogFilter {
+	return eventLogFilter(token, TransferEventTopic, from, to)
+}
+
+// ApprovalLogFilter returns a filter for Approval events emitted by token,
+// optionally restricted to the indexed owner and spender (or approved)
+// addresses. A nil owner or spender matches any address.
+func ApprovalLogFilter(token types.Address, owner, spender *types.Address) *LogFilter {
+	return eventLogFilter(token, ApprovalEventTopic, owner, spender)
+}
+
+// eventLogFilter builds a filter for an event with two indexed address parameters.
+func eventLogFilter(contract types.Address, signature types.Hash, first, second *types.Address) *LogFilter {
+	f := NewLogFilter().SetTopic0(signature)
+	if contract != "" {
+		f.SetAddress(contract)
+	}
+	if first != nil {
+		f.SetTopic1(types.AddressToTopic(*first))
+	}
+	if second != nil {
+		f.SetTopic2(types.AddressToTopic(*second))
+	}
+	return f
+}
@@ -0,0 +1,98 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// FilterID identifies a filter installed on the node.
+type FilterID string
+
+// String returns the string representation.
+func (id FilterID) String() string {
+	return string(id)
+}
+
+// FilterChanges holds the result of eth_getFilterChanges.
+// Log filters populate Logs; block and pending transaction filters populate Hashes.
+type FilterChanges struct {
+	// Logs contains new logs for a log filter.
+	Logs []types.Log
+	// Hashes contains new block or transaction hashes.
+	Hashes []types.Hash
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FilterChanges) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal filter changes: %w", err)
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+
+	// Block and pending transaction filters return plain hash strings.
+	if len(raw[0]) > 0 && raw[0][0] == '"' {
+		return json.Unmarshal(data, &f.Hashes)
+	}
+	return json.Unmarshal(data, &f.Logs)
+}
+
+// NewFilter installs a log filter and returns its ID.
+// Poll it with GetFilterChanges and remove it with UninstallFilter.
+func (c *Client) NewFilter(ctx context.Context, filter *LogFilter) (FilterID, error) {
+	var result FilterID
+	if err := c.rpc.Call(ctx, "eth_newFilter", []interface{}{filter}, &result); err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+// NewBlockFilter installs a filter that reports new block hashes.
+func (c *Client) NewBlockFilter(ctx context.Context) (FilterID, error) {
+	var result FilterID
+	if err := c.rpc.Call(ctx, "eth_newBlockFilter", nil, &result); err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+// NewPendingTransactionFilter installs a filter that reports new pending transaction hashes.
+func (c *Client) NewPendingTransactionFilter(ctx context.Context) (FilterID, error) {
+	var result FilterID
+	if err := c.rpc.Call(ctx, "eth_newPendingTransactionFilter", nil, &result); err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+// GetFilterChanges returns the changes since the filter was last polled.
+func (c *Client) GetFilterChanges(ctx context.Context, id FilterID) (*FilterChanges, error) {
+	var result FilterChanges
+	if err := c.rpc.Call(ctx, "eth_getFilterChanges", []interface{}{id.String()}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFilterLogs returns all logs matching a log filter.
+func (c *Client) GetFilterLogs(ctx context.Context, id FilterID) ([]types.Log, error) {
+	var result []types.Log
+	if err := c.rpc.Call(ctx, "eth_getFilterLogs", []interface{}{id.String()}, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UninstallFilter removes a filter. Returns false if the filter did not exist.
+func (c *Client) UninstallFilter(ctx context.Context, id FilterID) (bool, error) {
+	var result bool
+	if err := c.rpc.Call(ctx, "eth_uninstallFilter", []interface{}{id.String()}, &result); err != nil {
+		return false, err
+	}
+	return result, nil
+}
@@ -0,0 +1,202 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// DefaultLogChunkSize is the block window used by GetLogsChunked when chunkSize is 0.
+const DefaultLogChunkSize = 2000
+
+// GetLogsChunked returns logs matching the filter, splitting the
+// [FromBlock, ToBlock] range into windows of chunkSize blocks and querying
+// them one at a time. Windows that still return too many results are
+// bisected until they succeed or shrink to a single block.
+// Tags in FromBlock/ToBlock are resolved to block numbers first.
+// The filter must not use BlockHash.
+func (c *Client) GetLogsChunked(ctx context.Context, filter *LogFilter, chunkSize uint64) ([]types.Log, error) {
+	return c.GetLogsChunkedConcurrent(ctx, filter, chunkSize, 1)
+}
+
+// GetLogsChunkedConcurrent is like GetLogsChunked but queries up to
+// concurrency windows at a time. Logs are still returned in block order.
+func (c *Client) GetLogsChunkedConcurrent(ctx context.Context, filter *LogFilter, chunkSize uint64, concurrency int) ([]types.Log, error) {
+	if filter.BlockHash != nil {
+		return nil, fmt.Errorf("%w: GetLogsChunked does not support BlockHash filters", sdkerrors.ErrInvalidParameter)
+	}
+	if chunkSize == 0 {
+		chunkSize = DefaultLogChunkSize
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	from, err := c.resolveBlockNumber(ctx, filter.FromBlock, BlockEarliest)
+	if err != nil {
+		return nil, err
+	}
+	to, err := c.resolveBlockNumber(ctx, filter.ToBlock, BlockLatest)
+	if err != nil {
+		return nil, err
+	}
+	if to < from {
+		return nil, nil
+	}
+
+	// Windows are generated lazily and their logs merged in order as they
+	// complete, so memory does not grow with the number of windows. The first
+	// failure cancels the in-flight windows and stops dispatching new ones.
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		logs     []types.Log
+		pending  = make(map[int][]types.Log)
+		next     int
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+
+dispatch:
+	for i, start := 0, from; ; i++ {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			<-sem
+			break dispatch
+		}
+
+		end := start + chunkSize - 1
+		if end > to || end < start {
+			end = to
+		}
+
+		wg.Add(1)
+		go func(i int, from, to uint64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			window, err := c.getLogsBisect(ctx, filter, from, to)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			pending[i] = window
+			for w, ok := pending[next]; ok; w, ok = pending[next] {
+				logs = append(logs, w...)
+				delete(pending, next)
+				next++
+			}
+		}(i, start, end)
+
+		if end == to {
+			break
+		}
+		start = end + 1
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := parent.Err(); err != nil {
+		return nil, client.ContextError(parent, err)
+	}
+	return logs, nil
+}
+
+// getLogsBisect queries [from, to] and splits the window in half whenever
+// the node rejects it as too large.
+func (c *Client) getLogsBisect(ctx context.Context, filter *LogFilter, from, to uint64) ([]types.Log, error) {
+	window := *filter
+	window.SetBlockRange(BlockNumber(from), BlockNumber(to))
+
+	logs, err := c.GetLogs(ctx, &window)
+	if err == nil {
+		return logs, nil
+	}
+	if from == to || !isLogRangeError(err) {
+		return nil, err
+	}
+
+	mid := from + (to-from)/2
+	left, err := c.getLogsBisect(ctx, filter, from, mid)
+	if err != nil {
+		return nil, err
+	}
+	right, err := c.getLogsBisect(ctx, filter, mid+1, to)
+	if err != nil {
+		return nil, err
+	}
+	return append(left, right...), nil
+}
+
+// resolveBlockNumber converts a block number or tag to a concrete block number.
+// An empty value resolves to def.
+func (c *Client) resolveBlockNumber(ctx context.Context, block, def BlockNumberOrTag) (uint64, error) {
+	if block == "" {
+		block = def
+	}
+	switch block {
+	case BlockEarliest:
+		return 0, nil
+	case BlockLatest:
+		return c.BlockNumber(ctx)
+	}
+	if !block.IsTag() {
+		return block.Uint64(), nil
+	}
+
+	b, err := c.GetBlockByNumber(ctx, block, false)
+	if err != nil {
+		return 0, err
+	}
+	if b == nil {
+		return 0, fmt.Errorf("block %s not found", block)
+	}
+	return b.Number.Uint64(), nil
+}
+
+// logRangeErrorMessages are fragments of node errors that indicate an
+// eth_getLogs query covered too many blocks or returned too many results.
+var logRangeErrorMessages = []string{
+	"query returned more than",
+	"response size exceeded",
+	"response size should not greater than",
+	"block range",
+	"range too large",
+	"range is too large",
+	"too many results",
+}
+
+// isLogRangeError returns true if err indicates the eth_getLogs window was too large.
+func isLogRangeError(err error) bool {
+	var rpcErr *sdkerrors.JSONRPCError
+	if sdkerrors.As(err, &rpcErr) && rpcErr.Code == -32005 {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range logRangeErrorMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+func TestGetLogsChunkedCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req client.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		// Cancel the caller while the first window is being served.
+		calls.Add(1)
+		cancel()
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": []interface{}{}})
+	}))
+	defer srv.Close()
+	c := NewClient(client.NewJSONRPCClient(client.NewHTTPClient(client.HTTPClientConfig{BaseURL: srv.URL})))
+
+	filter := NewLogFilter().SetBlockRange(BlockNumber(0), BlockNumber(99))
+	_, err := c.GetLogsChunked(ctx, filter, 10)
+	if !errors.Is(err, sdkerrors.ErrContextCanceled) {
+		t.Errorf("GetLogsChunked() error = %v, want ErrContextCanceled", err)
+	}
+	if n := calls.Load(); n > 2 {
+		t.Errorf("made %d eth_getLogs calls after cancellation, want at most 2", n)
+	}
+}
+
+func TestGetLogsChunkedConcurrentStopsOnError(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req client.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		calls.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0", "id": req.ID,
+			"error": map[string]interface{}{"code": -32602, "message": "invalid argument"},
+		})
+	}))
+	defer srv.Close()
+	c := NewClient(client.NewJSONRPCClient(client.NewHTTPClient(client.HTTPClientConfig{BaseURL: srv.URL})))
+
+	// A million windows: only the first few may be dispatched.
+	filter := NewLogFilter().SetBlockRange(BlockNumber(0), BlockNumber(9_999_999))
+	if _, err := c.GetLogsChunkedConcurrent(context.Background(), filter, 10, 4); err == nil {
+		t.Fatal("GetLogsChunkedConcurrent() error = nil, want the window error")
+	}
+	if n := calls.Load(); n > 8 {
+		t.Errorf("made %d eth_getLogs calls after the first failure, want at most 8", n)
+	}
+}
+
+func TestGetLogsChunkedConcurrentOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req client.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		filter, _ := req.Params[0].(map[string]interface{})
+		from, _ := filter["fromBlock"].(string)
+		// Later windows answer first.
+		time.Sleep(time.Duration(100-BlockNumberOrTag(from).Uint64()) * time.Millisecond / 10)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0", "id": req.ID,
+			"result": []map[string]string{{"blockNumber": from}},
+		})
+	}))
+	defer srv.Close()
+	c := NewClient(client.NewJSONRPCClient(client.NewHTTPClient(client.HTTPClientConfig{BaseURL: srv.URL})))
+
+	filter := NewLogFilter().SetBlockRange(BlockNumber(0), BlockNumber(99))
+	logs, err := c.GetLogsChunkedConcurrent(context.Background(), filter, 10, 5)
+	if err != nil {
+		t.Fatalf("GetLogsChunkedConcurrent() error = %v", err)
+	}
+	if len(logs) != 10 {
+		t.Fatalf("got %d logs, want 10", len(logs))
+	}
+	for i, l := range logs {
+		if got := l.BlockNumber.Uint64(); got != uint64(10*i) {
+			t.Errorf("logs[%d] block = %d, want %d", i, got, 10*i)
+		}
+	}
+}
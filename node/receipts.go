@@ -0,0 +1,144 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// unsupportedMethodMessages are substrings of error messages returned by
+// networks that do not expose a JSON-RPC method.
+var unsupportedMethodMessages = []string{
+	"method not found",
+	"does not exist",
+	"not supported",
+	"unsupported method",
+	"not available",
+}
+
+// alchemyReceiptsResult is the result of alchemy_getTransactionReceipts.
+type alchemyReceiptsResult struct {
+	Receipts []types.TransactionReceipt `json:"receipts"`
+}
+
+// GetAlchemyTransactionReceipts returns all transaction receipts for a block
+// using Alchemy's alchemy_getTransactionReceipts enhanced method.
+// It is not available on every network; see GetTransactionReceiptsForBlock.
+func (c *Client) GetAlchemyTransactionReceipts(ctx context.Context, block BlockNumberOrTag) ([]types.TransactionReceipt, error) {
+	if block == "" {
+		block = BlockLatest
+	}
+
+	params := map[string]string{"blockNumber": block.String()}
+	var result alchemyReceiptsResult
+	if err := c.rpc.Call(ctx, "alchemy_getTransactionReceipts", []interface{}{params}, &result); err != nil {
+		return nil, err
+	}
+	return result.Receipts, nil
+}
+
+// GetAlchemyTransactionReceiptsByHash is like GetAlchemyTransactionReceipts
+// but selects the block by hash.
+func (c *Client) GetAlchemyTransactionReceiptsByHash(ctx context.Context, hash types.Hash) ([]types.TransactionReceipt, error) {
+	params := map[string]string{"blockHash": hash.String()}
+	var result alchemyReceiptsResult
+	if err := c.rpc.Call(ctx, "alchemy_getTransactionReceipts", []interface{}{params}, &result); err != nil {
+		return nil, err
+	}
+	return result.Receipts, nil
+}
+
+// GetTransactionReceiptsForBlock returns all transaction receipts for a block.
+// It uses eth_getBlockReceipts and, on networks where that method is not
+// available, falls back to fetching the block's transaction hashes and
+// requesting each receipt through JSON-RPC batches.
+// Receipts are returned in transaction order.
+func (c *Client) GetTransactionReceiptsForBlock(ctx context.Context, block BlockNumberOrTag) ([]types.TransactionReceipt, error) {
+	if block == "" {
+		block = BlockLatest
+	}
+
+	receipts, err := c.GetBlockReceipts(ctx, block)
+	if err == nil {
+		return receipts, nil
+	}
+	if !isUnsupportedMethodError(err) {
+		return nil, err
+	}
+
+	b, err := c.GetBlockByNumber(ctx, block, false)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, fmt.Errorf("block %s not found", block)
+	}
+	return c.getReceiptsBatched(ctx, b.TransactionHashes())
+}
+
+// getReceiptsBatched fetches the receipts for hashes using JSON-RPC batches.
+func (c *Client) getReceiptsBatched(ctx context.Context, hashes []types.Hash) ([]types.TransactionReceipt, error) {
+	receipts := make([]types.TransactionReceipt, len(hashes))
+
+	for start := 0; start < len(hashes); start += blockRangeBatchSize {
+		end := start + blockRangeBatchSize
+		if end > len(hashes) {
+			end = len(hashes)
+		}
+
+		results := make([]*types.TransactionReceipt, end-start)
+		calls := make([]client.BatchCall, end-start)
+		for i := range calls {
+			calls[i] = client.BatchCall{
+				Method: "eth_getTransactionReceipt",
+				Params: []interface{}{hashes[start+i].String()},
+				Result: &results[i],
+			}
+		}
+
+		batch, err := c.rpc.BatchCall(ctx, calls)
+		if err != nil {
+			return nil, err
+		}
+		for i, r := range results {
+			hash := hashes[start+i]
+			if batch[i].Error != nil {
+				return nil, fmt.Errorf("failed to get receipt for %s: %w", hash, batch[i].Error)
+			}
+			if r == nil {
+				return nil, fmt.Errorf("%w: receipt for %s not found", sdkerrors.ErrNilResponse, hash)
+			}
+			receipts[start+i] = *r
+		}
+	}
+
+	return receipts, nil
+}
+
+// isUnsupportedMethodError returns true if err indicates that the network does
+// not expose the called method.
+func isUnsupportedMethodError(err error) bool {
+	var httpErr *sdkerrors.HTTPError
+	if sdkerrors.As(err, &httpErr) {
+		return httpErr.StatusCode == 404
+	}
+
+	var rpcErr *sdkerrors.JSONRPCError
+	if !sdkerrors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.IsMethodNotFound() {
+		return true
+	}
+	msg := strings.ToLower(rpcErr.Message)
+	for _, fragment := range unsupportedMethodMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
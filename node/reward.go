@@ -0,0 +1,105 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// BlockReward is the fee income and burn of a block, in wei.
+type BlockReward struct {
+	// BlockNumber is the block number.
+	BlockNumber uint64
+	// BlockHash is the block hash.
+	BlockHash types.Hash
+	// FeeRecipient is the miner or validator fee recipient.
+	FeeRecipient types.Address
+	// BaseFeePerGas is the block's base fee, or nil before EIP-1559.
+	BaseFeePerGas *big.Int
+	// GasUsed is the gas used by all transactions in the block.
+	GasUsed uint64
+	// TransactionCount is the number of transactions in the block.
+	TransactionCount int
+	// PriorityFees is the sum of (effectiveGasPrice - baseFee) × gasUsed over
+	// all transactions: the tip income of the fee recipient.
+	PriorityFees *big.Int
+	// BurnedFees is baseFee × gasUsed, the execution fees burned by EIP-1559.
+	BurnedFees *big.Int
+	// BlobFeesBurned is the sum of blobGasUsed × blobGasPrice (EIP-4844).
+	BlobFeesBurned *big.Int
+}
+
+// TotalBurned returns the execution and blob fees burned by the block.
+func (r *BlockReward) TotalBurned() *big.Int {
+	return new(big.Int).Add(r.BurnedFees, r.BlobFeesBurned)
+}
+
+// BlockReward computes the priority fee income and burned fees of a block
+// from the block header and its transaction receipts.
+// Direct transfers to the fee recipient (e.g. MEV payments made inside a
+// transaction) and consensus-layer rewards are not included; they require
+// traces and beacon chain data respectively.
+func (c *Client) BlockReward(ctx context.Context, block BlockNumberOrTag) (*BlockReward, error) {
+	if block == "" {
+		block = BlockLatest
+	}
+
+	b, err := c.GetBlockByNumber(ctx, block, false)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, fmt.Errorf("block %s not found", block)
+	}
+
+	// Fetch receipts by number rather than tag so they match the header.
+	receipts, err := c.GetTransactionReceiptsForBlock(ctx, BlockNumber(b.Number.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+	for i := range receipts {
+		if !strings.EqualFold(receipts[i].BlockHash.String(), b.Hash.String()) {
+			return nil, fmt.Errorf("%w: receipts for block %d are from block %s; the block was reorged",
+				sdkerrors.ErrInvalidResponse, b.Number.Uint64(), receipts[i].BlockHash)
+		}
+	}
+
+	reward := &BlockReward{
+		BlockNumber:      b.Number.Uint64(),
+		BlockHash:        b.Hash,
+		FeeRecipient:     b.Miner,
+		GasUsed:          b.GasUsed.Uint64(),
+		TransactionCount: len(receipts),
+		PriorityFees:     new(big.Int),
+		BurnedFees:       new(big.Int),
+		BlobFeesBurned:   new(big.Int),
+	}
+
+	baseFee := new(big.Int)
+	if b.BaseFeePerGas != nil {
+		baseFee = b.BaseFeePerGas.BigInt()
+		reward.BaseFeePerGas = baseFee
+	}
+
+	for i := range receipts {
+		r := &receipts[i]
+		gasUsed := r.GasUsed.BigInt()
+
+		tip := new(big.Int).Sub(r.EffectiveGasPrice.BigInt(), baseFee)
+		if tip.Sign() > 0 {
+			reward.PriorityFees.Add(reward.PriorityFees, tip.Mul(tip, gasUsed))
+		}
+		reward.BurnedFees.Add(reward.BurnedFees, new(big.Int).Mul(baseFee, gasUsed))
+
+		if r.BlobGasUsed != nil && r.BlobGasPrice != nil {
+			blobFee := new(big.Int).Mul(r.BlobGasUsed.BigInt(), r.BlobGasPrice.BigInt())
+			reward.BlobFeesBurned.Add(reward.BlobFeesBurned, blobFee)
+		}
+	}
+
+	return reward, nil
+}
@@ -0,0 +1,365 @@
+package node
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// LogEventType distinguishes logs being added to or removed from the canonical chain.
+type LogEventType int
+
+// Log event types.
+const (
+	// LogAdded is emitted once a log is Confirmations blocks deep.
+	LogAdded LogEventType = iota
+	// LogRemoved is emitted when a previously added log's block was reorganized out.
+	LogRemoved
+)
+
+// String returns the string representation of the event type.
+func (t LogEventType) String() string {
+	switch t {
+	case LogAdded:
+		return "added"
+	case LogRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// LogEvent is a log delivered by a LogWatcher.
+type LogEvent struct {
+	// Type is LogAdded or LogRemoved.
+	Type LogEventType
+	// Log is the log entry.
+	Log types.Log
+}
+
+// LogWatcherConfig configures a LogWatcher.
+type LogWatcherConfig struct {
+	// Confirmations is the number of blocks a log must be buried under before
+	// it is emitted (0 emits logs from the head block).
+	Confirmations uint64
+	// PollInterval is the time between polls (default: 12s).
+	PollInterval time.Duration
+	// StartBlock is the first block to scan (0 starts at the current confirmed head).
+	StartBlock uint64
+	// ReorgWindow is the number of confirmed blocks re-checked for reorgs on
+	// each poll (default: 64, at most MaxBlockRange-1).
+	ReorgWindow uint64
+	// RetryMaxDelay caps the backoff between polls after failures
+	// (default: 5m, at least PollInterval).
+	RetryMaxDelay time.Duration
+	// ChunkSize is the block window per eth_getLogs call (default: DefaultLogChunkSize).
+	ChunkSize uint64
+	// EventBuffer is the capacity of the Events channel (default: 256).
+	EventBuffer int
+}
+
+// withDefaults returns a copy of the config with default values applied.
+func (c LogWatcherConfig) withDefaults() LogWatcherConfig {
+	if c.PollInterval <= 0 {
+		c.PollInterval = 12 * time.Second
+	}
+	if c.ReorgWindow == 0 {
+		c.ReorgWindow = 64
+	}
+	if c.ReorgWindow >= MaxBlockRange {
+		c.ReorgWindow = MaxBlockRange - 1
+	}
+	if c.RetryMaxDelay <= 0 {
+		c.RetryMaxDelay = 5 * time.Minute
+	}
+	if c.RetryMaxDelay < c.PollInterval {
+		c.RetryMaxDelay = c.PollInterval
+	}
+	if c.ChunkSize == 0 {
+		c.ChunkSize = DefaultLogChunkSize
+	}
+	if c.EventBuffer <= 0 {
+		c.EventBuffer = 256
+	}
+	return c
+}
+
+// trackedBlock records the hash of a confirmed block and the logs emitted for it.
+type trackedBlock struct {
+	hash types.Hash
+	logs []types.Log
+}
+
+// LogWatcher polls eth_getLogs and emits logs once they reach a configured
+// confirmation depth. The hashes of the last ReorgWindow confirmed blocks are
+// re-checked on every poll, whether or not they had matching logs; if a
+// block's hash changes, the logs emitted for it and every later block are
+// emitted again as LogRemoved and those blocks are rescanned.
+//
+// A failed poll is retried with exponential backoff, starting at
+// PollInterval and capped at RetryMaxDelay; the watcher only stops when its
+// context is done or Stop is called.
+type LogWatcher struct {
+	client *Client
+	filter LogFilter
+	cfg    LogWatcherConfig
+
+	events chan LogEvent
+	next   uint64
+	// tracked holds the confirmed blocks within the reorg window, keyed by number.
+	tracked map[uint64]*trackedBlock
+
+	mu      sync.Mutex
+	err     error
+	started bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewLogWatcher creates a LogWatcher for logs matching filter.
+// FromBlock, ToBlock and BlockHash on the filter are ignored.
+func (c *Client) NewLogWatcher(filter *LogFilter, cfg LogWatcherConfig) *LogWatcher {
+	cfg = cfg.withDefaults()
+
+	f := *filter
+	f.FromBlock = ""
+	f.ToBlock = ""
+	f.BlockHash = nil
+
+	return &LogWatcher{
+		client:  c,
+		filter:  f,
+		cfg:     cfg,
+		events:  make(chan LogEvent, cfg.EventBuffer),
+		next:    cfg.StartBlock,
+		tracked: make(map[uint64]*trackedBlock),
+		done:    make(chan struct{}),
+	}
+}
+
+// Events returns the channel on which log events are delivered.
+// The channel is closed when the watcher stops.
+func (w *LogWatcher) Events() <-chan LogEvent {
+	return w.events
+}
+
+// Err returns the error of the most recent poll, or nil if it succeeded.
+func (w *LogWatcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// Start begins polling in a background goroutine until ctx is cancelled or
+// Stop is called. Calling Start more than once has no effect.
+func (w *LogWatcher) Start(ctx context.Context) {
+	w.mu.Lock()
+	if w.started {
+		w.mu.Unlock()
+		return
+	}
+	w.started = true
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.mu.Unlock()
+
+	go w.run(ctx)
+}
+
+// Stop stops the watcher and waits for the polling goroutine to exit.
+func (w *LogWatcher) Stop() {
+	w.mu.Lock()
+	cancel := w.cancel
+	started := w.started
+	w.mu.Unlock()
+
+	if !started {
+		return
+	}
+	cancel()
+	<-w.done
+}
+
+func (w *LogWatcher) run(ctx context.Context) {
+	defer close(w.done)
+	defer close(w.events)
+
+	delay := w.cfg.PollInterval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		err := w.poll(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		w.mu.Lock()
+		w.err = err
+		w.mu.Unlock()
+
+		if err == nil {
+			delay = w.cfg.PollInterval
+			timer.Reset(delay)
+			continue
+		}
+		timer.Reset(delay)
+		delay *= 2
+		if delay > w.cfg.RetryMaxDelay {
+			delay = w.cfg.RetryMaxDelay
+		}
+	}
+}
+
+// poll checks tracked blocks for reorgs and emits newly confirmed logs.
+func (w *LogWatcher) poll(ctx context.Context) error {
+	head, err := w.client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if head < w.cfg.Confirmations {
+		return nil
+	}
+	confirmed := head - w.cfg.Confirmations
+
+	if w.next == 0 && w.cfg.StartBlock == 0 {
+		w.next = confirmed
+	}
+
+	if err := w.checkReorgs(ctx, confirmed); err != nil {
+		return err
+	}
+
+	var floor uint64
+	if confirmed >= w.cfg.ReorgWindow {
+		floor = confirmed - w.cfg.ReorgWindow
+	}
+
+	if w.next <= confirmed {
+		// Record the hashes of the new blocks within the reorg window before
+		// fetching their logs: if the chain reorganizes in between, the next
+		// poll sees the hashes change and rescans.
+		first := w.next
+		if first < floor {
+			first = floor
+		}
+		blocks, err := w.client.GetBlockRange(ctx, first, confirmed, false, 1)
+		if err != nil {
+			return err
+		}
+
+		filter := w.filter
+		filter.SetBlockRange(BlockNumber(w.next), BlockNumber(confirmed))
+		logs, err := w.client.GetLogsChunked(ctx, &filter, w.cfg.ChunkSize)
+		if err != nil {
+			return err
+		}
+
+		for i, block := range blocks {
+			w.tracked[first+uint64(i)] = &trackedBlock{hash: block.Hash}
+		}
+
+		for _, log := range logs {
+			if log.Removed {
+				continue
+			}
+			if tb, ok := w.tracked[log.BlockNumber.Uint64()]; ok {
+				// Track the hash the emitted logs belong to.
+				tb.hash = log.BlockHash
+				tb.logs = append(tb.logs, log)
+			}
+
+			if err := w.emit(ctx, LogEvent{Type: LogAdded, Log: log}); err != nil {
+				return err
+			}
+		}
+		w.next = confirmed + 1
+	}
+
+	// Forget blocks that have left the reorg window.
+	for n := range w.tracked {
+		if n < floor {
+			delete(w.tracked, n)
+		}
+	}
+	return nil
+}
+
+// checkReorgs re-fetches the hash of each tracked block. From the oldest
+// block whose hash changed onwards, the emitted logs are emitted as
+// LogRemoved and the blocks are scheduled for rescan. Tracked blocks above
+// confirmed are treated as changed: the chain is now shorter than it was.
+func (w *LogWatcher) checkReorgs(ctx context.Context, confirmed uint64) error {
+	if len(w.tracked) == 0 {
+		return nil
+	}
+
+	numbers := make([]uint64, 0, len(w.tracked))
+	for n := range w.tracked {
+		numbers = append(numbers, n)
+	}
+	// Newest first, so removals are emitted in reverse chain order.
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] > numbers[j] })
+
+	reorged := false
+	var fork uint64
+	oldest, newest := numbers[len(numbers)-1], numbers[0]
+	if newest > confirmed {
+		reorged = true
+		fork = confirmed + 1
+		newest = confirmed
+	}
+	if oldest <= newest {
+		blocks, err := w.client.GetBlockRange(ctx, oldest, newest, false, 1)
+		if err != nil {
+			return err
+		}
+		for n := oldest; n <= newest; n++ {
+			tb, ok := w.tracked[n]
+			if ok && blocks[n-oldest].Hash != tb.hash {
+				reorged = true
+				fork = n
+				break
+			}
+		}
+	}
+	if !reorged {
+		return nil
+	}
+
+	for _, n := range numbers {
+		if n < fork {
+			break
+		}
+		tb := w.tracked[n]
+		for i := len(tb.logs) - 1; i >= 0; i-- {
+			log := tb.logs[i]
+			log.Removed = true
+			if err := w.emit(ctx, LogEvent{Type: LogRemoved, Log: log}); err != nil {
+				return err
+			}
+		}
+		delete(w.tracked, n)
+	}
+	if fork < w.next {
+		w.next = fork
+	}
+	return nil
+}
+
+// emit delivers an event, blocking until it is received or ctx is done.
+func (w *LogWatcher) emit(ctx context.Context, event LogEvent) error {
+	select {
+	case w.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
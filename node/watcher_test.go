@@ -0,0 +1,239 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// fakeChain is a JSON-RPC server backed by a mutable in-memory chain.
+type fakeChain struct {
+	mu       sync.Mutex
+	head     uint64
+	fork     map[uint64]int  // fork version of each block; 0 if absent
+	logs     map[uint64]bool // blocks that hold a matching log
+	failNext int             // number of upcoming requests answered with 500
+	scanned  uint64          // highest toBlock of the eth_getLogs calls served
+}
+
+func newFakeChain(head uint64) *fakeChain {
+	return &fakeChain{head: head, fork: make(map[uint64]int), logs: make(map[uint64]bool)}
+}
+
+// blockHash returns the hash of block n on the current fork. The caller
+// must hold c.mu.
+func (c *fakeChain) blockHash(n uint64) types.Hash {
+	return types.Hash(fmt.Sprintf("0x%056x%08x", n, c.fork[n]))
+}
+
+// reorg replaces block n with a new version holding a log if withLog is set.
+func (c *fakeChain) reorg(n uint64, withLog bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fork[n]++
+	c.logs[n] = withLog
+}
+
+// setHead moves the chain head.
+func (c *fakeChain) setHead(head uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head = head
+}
+
+func (c *fakeChain) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failNext > 0 {
+		c.failNext--
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(string(body)), "[") {
+		var reqs []client.JSONRPCRequest
+		_ = json.Unmarshal(body, &reqs)
+		resps := make([]map[string]interface{}, len(reqs))
+		for i, req := range reqs {
+			resps[i] = c.respond(req)
+		}
+		_ = json.NewEncoder(w).Encode(resps)
+		return
+	}
+
+	var req client.JSONRPCRequest
+	_ = json.Unmarshal(body, &req)
+	_ = json.NewEncoder(w).Encode(c.respond(req))
+}
+
+// respond answers a single request. The caller must hold c.mu.
+func (c *fakeChain) respond(req client.JSONRPCRequest) map[string]interface{} {
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	switch req.Method {
+	case "eth_blockNumber":
+		resp["result"] = hex.EncodeUint64(c.head)
+	case "eth_getBlockByNumber":
+		n, _ := hex.DecodeUint64(req.Params[0].(string))
+		if n > c.head {
+			resp["result"] = nil
+			break
+		}
+		resp["result"] = map[string]interface{}{"number": hex.EncodeUint64(n), "hash": c.blockHash(n)}
+	case "eth_getLogs":
+		filter := req.Params[0].(map[string]interface{})
+		from, _ := hex.DecodeUint64(filter["fromBlock"].(string))
+		to, _ := hex.DecodeUint64(filter["toBlock"].(string))
+		if to > c.scanned {
+			c.scanned = to
+		}
+		logs := []types.Log{}
+		for n := from; n <= to && n <= c.head; n++ {
+			if c.logs[n] {
+				logs = append(logs, types.Log{
+					BlockNumber: types.Quantity(hex.EncodeUint64(n)),
+					BlockHash:   c.blockHash(n),
+				})
+			}
+		}
+		resp["result"] = logs
+	default:
+		resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+	}
+	return resp
+}
+
+// newWatcherTestClient creates a client backed by chain.
+func newWatcherTestClient(t *testing.T, chain *fakeChain) *Client {
+	t.Helper()
+	srv := httptest.NewServer(chain)
+	t.Cleanup(srv.Close)
+	return NewClient(client.NewJSONRPCClient(client.NewHTTPClient(client.HTTPClientConfig{BaseURL: srv.URL})))
+}
+
+// nextEvent waits for the next watcher event.
+func nextEvent(t *testing.T, w *LogWatcher) LogEvent {
+	t.Helper()
+	select {
+	case event, ok := <-w.Events():
+		if !ok {
+			t.Fatalf("Events closed; Err() = %v", w.Err())
+		}
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timed out waiting for an event; Err() = %v", w.Err())
+		return LogEvent{}
+	}
+}
+
+func TestLogWatcherReorgWithoutLogs(t *testing.T) {
+	chain := newFakeChain(10)
+	c := newWatcherTestClient(t, chain)
+
+	w := c.NewLogWatcher(&LogFilter{}, LogWatcherConfig{
+		Confirmations: 2,
+		StartBlock:    5,
+		PollInterval:  5 * time.Millisecond,
+	})
+	w.Start(context.Background())
+	defer w.Stop()
+
+	// Let the watcher confirm blocks 5-8, none of which hold a log.
+	waitFor(t, func() bool {
+		chain.mu.Lock()
+		defer chain.mu.Unlock()
+		return chain.scanned >= 8
+	})
+
+	// Block 8 is replaced by a version that holds a matching log.
+	chain.reorg(8, true)
+	chain.setHead(11)
+
+	event := nextEvent(t, w)
+	if event.Type != LogAdded || event.Log.BlockNumber.Uint64() != 8 {
+		t.Fatalf("event = %s at block %d, want added at block 8", event.Type, event.Log.BlockNumber.Uint64())
+	}
+	chain.mu.Lock()
+	want := chain.blockHash(8)
+	chain.mu.Unlock()
+	if event.Log.BlockHash != want {
+		t.Errorf("log block hash = %s, want %s", event.Log.BlockHash, want)
+	}
+}
+
+func TestLogWatcherReorgRemovesLogs(t *testing.T) {
+	chain := newFakeChain(10)
+	chain.logs[8] = true
+	c := newWatcherTestClient(t, chain)
+
+	w := c.NewLogWatcher(&LogFilter{}, LogWatcherConfig{
+		Confirmations: 2,
+		StartBlock:    5,
+		PollInterval:  5 * time.Millisecond,
+	})
+	w.Start(context.Background())
+	defer w.Stop()
+
+	if event := nextEvent(t, w); event.Type != LogAdded {
+		t.Fatalf("first event = %s, want added", event.Type)
+	}
+
+	// Block 7 is replaced, orphaning block 8 and its log.
+	chain.mu.Lock()
+	chain.fork[7]++
+	chain.fork[8]++
+	chain.logs[8] = false
+	chain.mu.Unlock()
+
+	event := nextEvent(t, w)
+	if event.Type != LogRemoved || event.Log.BlockNumber.Uint64() != 8 || !event.Log.Removed {
+		t.Fatalf("event = %s at block %d, want removed at block 8", event.Type, event.Log.BlockNumber.Uint64())
+	}
+}
+
+func TestLogWatcherRetriesFailedPolls(t *testing.T) {
+	chain := newFakeChain(10)
+	chain.logs[9] = true
+	chain.failNext = 3
+	c := newWatcherTestClient(t, chain)
+
+	w := c.NewLogWatcher(&LogFilter{}, LogWatcherConfig{
+		Confirmations: 1,
+		StartBlock:    9,
+		PollInterval:  5 * time.Millisecond,
+		RetryMaxDelay: 20 * time.Millisecond,
+	})
+	w.Start(context.Background())
+	defer w.Stop()
+
+	event := nextEvent(t, w)
+	if event.Type != LogAdded || event.Log.BlockNumber.Uint64() != 9 {
+		t.Fatalf("event = %s at block %d, want added at block 9", event.Type, event.Log.BlockNumber.Uint64())
+	}
+	waitFor(t, func() bool { return w.Err() == nil })
+}
+
+// waitFor polls cond until it holds.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,48 @@
+// Package prices provides the client for Alchemy's Prices API.
+package prices
+
+import (
+	"strings"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/client"
+)
+
+// DefaultBaseURL is the Prices API base URL. Unlike the Node and NFT APIs,
+// the Prices API is served from a single host for all networks.
+const DefaultBaseURL = "https://api.g.alchemy.com/prices/v1"
+
+// Client is the Prices API client.
+// It is safe for concurrent use by multiple goroutines.
+type Client struct {
+	http    *client.HTTPClient
+	baseURL string
+	apiKey  string
+}
+
+// NewClient creates a new Prices API client.
+// Requests are sent to baseURL/apiKey/<path>; an empty baseURL uses DefaultBaseURL.
+func NewClient(httpClient *client.HTTPClient, baseURL, apiKey string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		http:    httpClient,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+	}
+}
+
+// HTTP returns the underlying HTTP client.
+func (c *Client) HTTP() *client.HTTPClient {
+	return c.http
+}
+
+// BaseURL returns the Prices API base URL, without the API key.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// endpoint builds the Prices API URL for the given path.
+func (c *Client) endpoint(path string) string {
+	return c.baseURL + "/" + c.apiKey + "/" + path
+}
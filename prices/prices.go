@@ -0,0 +1,170 @@
+package prices
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/ABT-Tech-Limited/alchemy-go/errors"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// Request size limits of the Prices API.
+const (
+	// MaxSymbolsPerRequest is the maximum number of symbols accepted by
+	// GetTokenPricesBySymbol.
+	MaxSymbolsPerRequest = 25
+	// MaxAddressesPerRequest is the maximum number of addresses accepted by
+	// GetTokenPricesByAddress.
+	MaxAddressesPerRequest = 25
+)
+
+// Price is a token price in a single currency.
+type Price struct {
+	// Currency is the quote currency (e.g. "usd").
+	Currency string `json:"currency"`
+	// Value is the price as a decimal string.
+	Value string `json:"value"`
+	// LastUpdatedAt is when the price was last updated (ISO 8601).
+	LastUpdatedAt string `json:"lastUpdatedAt"`
+}
+
+// Float returns the price as a float64.
+func (p *Price) Float() (float64, error) {
+	return strconv.ParseFloat(p.Value, 64)
+}
+
+// TokenPrice holds the prices of a single token.
+type TokenPrice struct {
+	// Symbol is the token symbol (set for by-symbol lookups).
+	Symbol string `json:"symbol,omitempty"`
+	// Network is the network identifier (set for by-address lookups).
+	Network string `json:"network,omitempty"`
+	// Address is the token contract address (set for by-address lookups).
+	Address *types.Address `json:"address,omitempty"`
+	// Prices is the list of prices, one per currency.
+	Prices []Price `json:"prices"`
+	// Error is set if the token could not be priced (e.g. unknown symbol).
+	Error *string `json:"error,omitempty"`
+}
+
+// Price returns the price in the given currency (case-insensitive).
+func (t *TokenPrice) Price(currency string) (*Price, bool) {
+	for i := range t.Prices {
+		if strings.EqualFold(t.Prices[i].Currency, currency) {
+			return &t.Prices[i], true
+		}
+	}
+	return nil, false
+}
+
+// USD returns the price in US dollars.
+func (t *TokenPrice) USD() (*Price, bool) {
+	return t.Price("usd")
+}
+
+// PriceInUSD returns the US dollar price as a float64.
+// Returns false if the token has no parseable USD price.
+func (t *TokenPrice) PriceInUSD() (float64, bool) {
+	p, ok := t.USD()
+	if !ok {
+		return 0, false
+	}
+	v, err := p.Float()
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// TokenAddressRef identifies a token by network and contract address.
+type TokenAddressRef struct {
+	// Network is the network identifier (e.g. alchemy.EthMainnet.String()).
+	Network string `json:"network"`
+	// Address is the token contract address.
+	Address types.Address `json:"address"`
+}
+
+// key returns the lookup key used to match responses to requests.
+func (r TokenAddressRef) key() string {
+	return r.Network + ":" + strings.ToLower(r.Address.String())
+}
+
+// tokenPricesResponse is the response envelope of the Prices API.
+type tokenPricesResponse struct {
+	Data []TokenPrice `json:"data"`
+}
+
+// GetTokenPricesBySymbol returns the current prices of the given token symbols.
+// Results are in request order; unknown symbols have Error set.
+func (c *Client) GetTokenPricesBySymbol(ctx context.Context, symbols []string) ([]TokenPrice, error) {
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("%w: at least one symbol is required", sdkerrors.ErrInvalidParameter)
+	}
+	if len(symbols) > MaxSymbolsPerRequest {
+		return nil, fmt.Errorf("%w: at most %d symbols are allowed, got %d", sdkerrors.ErrInvalidParameter, MaxSymbolsPerRequest, len(symbols))
+	}
+
+	query := url.Values{}
+	for _, symbol := range symbols {
+		query.Add("symbols", symbol)
+	}
+
+	body, err := c.http.GetURL(ctx, c.endpoint("tokens/by-symbol")+"?"+query.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var result tokenPricesResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+// GetTokenPricesByAddress returns the current prices of tokens identified by
+// network and contract address, so tokens on several networks can be priced
+// in one call. Results are in request order; tokens that could not be priced
+// have Error set.
+func (c *Client) GetTokenPricesByAddress(ctx context.Context, refs []TokenAddressRef) ([]TokenPrice, error) {
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("%w: at least one address is required", sdkerrors.ErrInvalidParameter)
+	}
+	if len(refs) > MaxAddressesPerRequest {
+		return nil, fmt.Errorf("%w: at most %d addresses are allowed, got %d", sdkerrors.ErrInvalidParameter, MaxAddressesPerRequest, len(refs))
+	}
+
+	reqBody := map[string]interface{}{"addresses": refs}
+	body, err := c.http.PostURL(ctx, c.endpoint("tokens/by-address"), reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var result tokenPricesResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]TokenPrice, len(result.Data))
+	for _, p := range result.Data {
+		if p.Address == nil {
+			continue
+		}
+		byKey[TokenAddressRef{Network: p.Network, Address: *p.Address}.key()] = p
+	}
+
+	prices := make([]TokenPrice, len(refs))
+	for i, ref := range refs {
+		p, ok := byKey[ref.key()]
+		if !ok {
+			address := ref.Address
+			msg := "price not returned"
+			p = TokenPrice{Network: ref.Network, Address: &address, Error: &msg}
+		}
+		prices[i] = p
+	}
+	return prices, nil
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// AccessList is an EIP-2930 access list: the addresses and storage slots a
+// transaction plans to access.
+type AccessList []AccessListEntry
+
+// AddAddress adds an address without storage keys. It is a no-op if the
+// address is already present.
+func (l *AccessList) AddAddress(address Address) *AccessList {
+	l.entry(address)
+	return l
+}
+
+// AddStorageKeys adds storage keys for an address, adding the address if
+// necessary. Keys already present are not duplicated.
+func (l *AccessList) AddStorageKeys(address Address, keys ...Hash) *AccessList {
+	entry := l.entry(address)
+	for _, key := range keys {
+		if !containsHash(entry.StorageKeys, key) {
+			entry.StorageKeys = append(entry.StorageKeys, key)
+		}
+	}
+	return l
+}
+
+// Contains reports whether the address is in the access list.
+func (l AccessList) Contains(address Address) bool {
+	return l.index(address) >= 0
+}
+
+// ContainsStorageKey reports whether the storage key of the address is in
+// the access list.
+func (l AccessList) ContainsStorageKey(address Address, key Hash) bool {
+	i := l.index(address)
+	return i >= 0 && containsHash(l[i].StorageKeys, key)
+}
+
+// Addresses returns the addresses in the access list.
+func (l AccessList) Addresses() []Address {
+	addrs := make([]Address, len(l))
+	for i, entry := range l {
+		addrs[i] = entry.Address
+	}
+	return addrs
+}
+
+// StorageKeyCount returns the total number of storage keys.
+func (l AccessList) StorageKeyCount() int {
+	n := 0
+	for _, entry := range l {
+		n += len(entry.StorageKeys)
+	}
+	return n
+}
+
+// MarshalJSON implements json.Marshaler. Entries without storage keys are
+// encoded with an empty storageKeys array, as nodes require.
+func (l AccessList) MarshalJSON() ([]byte, error) {
+	entries := make([]AccessListEntry, len(l))
+	for i, entry := range l {
+		entries[i] = entry
+		if entries[i].StorageKeys == nil {
+			entries[i].StorageKeys = []Hash{}
+		}
+	}
+	return json.Marshal(entries)
+}
+
+// entry returns the entry for address, appending one if necessary.
+func (l *AccessList) entry(address Address) *AccessListEntry {
+	if i := l.index(address); i >= 0 {
+		return &(*l)[i]
+	}
+	*l = append(*l, AccessListEntry{Address: address, StorageKeys: []Hash{}})
+	return &(*l)[len(*l)-1]
+}
+
+// index returns the index of the address's entry, or -1.
+func (l AccessList) index(address Address) int {
+	for i := range l {
+		if strings.EqualFold(l[i].Address.String(), address.String()) {
+			return i
+		}
+	}
+	return -1
+}
+
+// containsHash reports whether hashes contains h.
+func containsHash(hashes []Hash, h Hash) bool {
+	for _, x := range hashes {
+		if strings.EqualFold(x.String(), h.String()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/keccak"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/rlp"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/secp256k1"
+)
+
+// setCodeAuthorizationMagic prefixes the payload signed by an EIP-7702
+// authorization.
+const setCodeAuthorizationMagic = 0x05
+
+// SetCodeAuthorization is an EIP-7702 authorization tuple: a signed
+// permission for an account to delegate its code to Address.
+type SetCodeAuthorization struct {
+	// ChainID is the chain the authorization is valid on (0 for any chain).
+	ChainID Quantity `json:"chainId"`
+	// Address is the delegation target.
+	Address Address `json:"address"`
+	// Nonce is the authorizing account's nonce.
+	Nonce Quantity `json:"nonce"`
+	// YParity is the y-parity of the signature.
+	YParity Quantity `json:"yParity"`
+	// R is the signature r value.
+	R Quantity `json:"r"`
+	// S is the signature s value.
+	S Quantity `json:"s"`
+}
+
+// SigningHash returns keccak256(0x05 || rlp([chainId, address, nonce])),
+// the hash signed by the authorizing account.
+func (a *SetCodeAuthorization) SigningHash() Hash {
+	payload := append([]byte{setCodeAuthorizationMagic},
+		rlp.List(rlp.Uint(a.ChainID.BigInt()), rlp.Bytes(a.Address.Bytes()), rlp.Uint(a.Nonce.BigInt()))...)
+	return hashOf(payload)
+}
+
+// Authority recovers the address of the account that signed the
+// authorization.
+func (a *SetCodeAuthorization) Authority() (Address, error) {
+	v := a.YParity.Uint64()
+	if v > 1 {
+		return "", fmt.Errorf("invalid authorization y-parity %d", v)
+	}
+	pub, err := secp256k1.RecoverPubkey(a.SigningHash().Bytes(), a.R.BigInt(), a.S.BigInt(), byte(v), true)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover authority: %w", err)
+	}
+	h := keccak.Sum256(pub)
+	return Address(hex.Encode(h[12:])), nil
+}
+
+// encodeAuthorizationList RLP-encodes an authorization list.
+func encodeAuthorizationList(list []SetCodeAuthorization) []byte {
+	entries := make([][]byte, len(list))
+	for i, a := range list {
+		entries[i] = rlp.List(
+			rlp.Uint(a.ChainID.BigInt()), rlp.Bytes(a.Address.Bytes()), rlp.Uint(a.Nonce.BigInt()),
+			rlp.Uint(a.YParity.BigInt()), rlp.Uint(a.R.BigInt()), rlp.Uint(a.S.BigInt()))
+	}
+	return rlp.List(entries...)
+}
+
+// decodeAuthorizationList decodes [[chainId, address, nonce, yParity, r, s], ...].
+func decodeAuthorizationList(item rlp.Item) ([]SetCodeAuthorization, error) {
+	if !item.IsList {
+		return nil, fmt.Errorf("invalid authorization list")
+	}
+	list := make([]SetCodeAuthorization, 0, len(item.List))
+	for _, e := range item.List {
+		if !e.IsList || len(e.List) != 6 || len(e.List[1].Bytes) != 20 {
+			return nil, fmt.Errorf("invalid authorization list entry")
+		}
+		for _, f := range e.List {
+			if f.IsList {
+				return nil, fmt.Errorf("invalid authorization list entry")
+			}
+		}
+		list = append(list, SetCodeAuthorization{
+			ChainID: quantityOf(e.List[0]),
+			Address: Address(hex.Encode(e.List[1].Bytes)),
+			Nonce:   quantityOf(e.List[2]),
+			YParity: quantityOf(e.List[3]),
+			R:       quantityOf(e.List[4]),
+			S:       quantityOf(e.List[5]),
+		})
+	}
+	return list, nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"math/big"
 )
 
 // Block represents an Ethereum block.
@@ -171,6 +172,18 @@ func (b *Block) TransactionCount() int {
 	return len(arr)
 }
 
+// TotalWithdrawn returns the sum of the block's withdrawals in wei.
+func (b *Block) TotalWithdrawn() *big.Int {
+	total := new(big.Int)
+	for i := range b.Withdrawals {
+		total.Add(total, b.Withdrawals[i].AmountWei())
+	}
+	return total
+}
+
+// gweiInWei is the number of wei in one Gwei.
+var gweiInWei = big.NewInt(1_000_000_000)
+
 // Withdrawal represents a validator withdrawal (Shanghai upgrade).
 type Withdrawal struct {
 	// Index is the withdrawal index.
@@ -179,10 +192,21 @@ type Withdrawal struct {
 	ValidatorIndex Quantity `json:"validatorIndex"`
 	// Address is the recipient address.
 	Address Address `json:"address"`
-	// Amount is the amount in Gwei.
+	// Amount is the amount in Gwei, not wei. Use AmountWei or AmountGwei
+	// to make the unit explicit.
 	Amount Quantity `json:"amount"`
 }
 
+// AmountGwei returns the withdrawal amount in Gwei.
+func (w *Withdrawal) AmountGwei() uint64 {
+	return w.Amount.Uint64()
+}
+
+// AmountWei returns the withdrawal amount in wei.
+func (w *Withdrawal) AmountWei() *big.Int {
+	return new(big.Int).Mul(w.Amount.BigInt(), gweiInWei)
+}
+
 // Transaction represents an Ethereum transaction.
 type Transaction struct {
 	// Hash is the transaction hash.
@@ -226,9 +250,11 @@ type Transaction struct {
 	// ChainID is the chain ID.
 	ChainID *Quantity `json:"chainId,omitempty"`
 	// AccessList is the access list (EIP-2930 and later).
-	AccessList []AccessListEntry `json:"accessList,omitempty"`
+	AccessList AccessList `json:"accessList,omitempty"`
 	// BlobVersionedHashes is the blob versioned hashes (EIP-4844).
 	BlobVersionedHashes []Hash `json:"blobVersionedHashes,omitempty"`
+	// AuthorizationList is the set code authorization list (EIP-7702).
+	AuthorizationList []SetCodeAuthorization `json:"authorizationList,omitempty"`
 }
 
 // AccessListEntry represents an entry in an access list.
@@ -247,6 +273,11 @@ func (tx *Transaction) TxType() int {
 	return int(tx.Type.Uint64())
 }
 
+// IsPending returns true if the transaction has not been included in a block.
+func (tx *Transaction) IsPending() bool {
+	return tx.BlockNumber == nil
+}
+
 // IsLegacy returns true if this is a legacy (type 0) transaction.
 func (tx *Transaction) IsLegacy() bool {
 	return tx.TxType() == 0
@@ -266,3 +297,14 @@ func (tx *Transaction) IsDynamicFee() bool {
 func (tx *Transaction) IsBlob() bool {
 	return tx.TxType() == 3
 }
+
+// IsSetCode returns true if this is an EIP-7702 set code (type 4) transaction.
+func (tx *Transaction) IsSetCode() bool {
+	return tx.TxType() == SetCodeTxType
+}
+
+// TxTypeName returns the canonical name of the transaction type, e.g.
+// "legacy" or "dynamic-fee".
+func (tx *Transaction) TxTypeName() string {
+	return TxType(tx.TxType()).String()
+}
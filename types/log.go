@@ -1,5 +1,9 @@
 package types
 
+import (
+	"strings"
+)
+
 // Log represents an Ethereum log entry.
 type Log struct {
 	// Address is the contract address that emitted the log.
@@ -53,3 +57,21 @@ func (l *Log) Topic3() Hash {
 	}
 	return l.Topics[3]
 }
+
+// AddressToTopic left-pads an address to the 32-byte topic form used for
+// indexed address parameters.
+func AddressToTopic(a Address) Hash {
+	hexAddr := strings.TrimPrefix(strings.ToLower(a.String()), "0x")
+	return Hash("0x" + strings.Repeat("0", 64-len(hexAddr)) + hexAddr)
+}
+
+// TopicToAddress extracts the address from a 32-byte topic holding an
+// indexed address parameter. Returns an empty address if the topic is not
+// a 32-byte hex value.
+func TopicToAddress(h Hash) Address {
+	s := strings.ToLower(h.String())
+	if len(s) != 66 || !strings.HasPrefix(s, "0x") {
+		return ""
+	}
+	return Address("0x" + s[26:])
+}
@@ -0,0 +1,225 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/keccak"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/rlp"
+)
+
+// DecodeRawTransaction decodes a signed transaction as produced for
+// eth_sendRawTransaction: legacy, EIP-2930, EIP-1559, EIP-4844 (including
+// the network form that carries blobs) or EIP-7702. The sender is recovered from the
+// signature and set as From; block fields are left nil.
+func DecodeRawTransaction(raw []byte) (*Transaction, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("empty raw transaction")
+	}
+
+	var tx *Transaction
+	var err error
+	if raw[0] >= 0xc0 {
+		tx, err = decodeLegacyTx(raw)
+	} else {
+		tx, err = decodeTypedTx(raw[0], raw[1:])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := tx.RecoverSender()
+	if err != nil {
+		return nil, err
+	}
+	tx.From = from
+	return tx, nil
+}
+
+// decodeLegacyTx decodes rlp([nonce, gasPrice, gas, to, value, data, v, r, s]).
+func decodeLegacyTx(raw []byte) (*Transaction, error) {
+	fields, err := decodeTxFields(raw, 9)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &Transaction{Hash: hashOf(raw)}
+	gasPrice := quantityOf(fields[1])
+	tx.GasPrice = &gasPrice
+	if err := setCommonFields(tx, fields[0], fields[2], fields[3], fields[4], fields[5]); err != nil {
+		return nil, err
+	}
+	tx.V, tx.R, tx.S = quantityOf(fields[6]), quantityOf(fields[7]), quantityOf(fields[8])
+
+	if chainID := tx.legacyChainID(); chainID != nil {
+		id := QuantityFromBigInt(chainID)
+		tx.ChainID = &id
+	}
+	return tx, nil
+}
+
+// decodeTypedTx decodes an EIP-2718 typed transaction envelope.
+func decodeTypedTx(txType byte, payload []byte) (*Transaction, error) {
+	item, err := rlp.Decode(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction encoding: %w", err)
+	}
+	if !item.IsList {
+		return nil, fmt.Errorf("invalid transaction encoding: expected list")
+	}
+
+	// Blob transactions in network form wrap the transaction with its
+	// blobs, commitments and proofs: [tx, blobs, commitments, proofs].
+	if txType == BlobTxType && len(item.List) == 4 && item.List[0].IsList {
+		item = item.List[0]
+	}
+	body := item.Raw
+
+	var want int
+	switch txType {
+	case AccessListTxType:
+		want = 11
+	case DynamicFeeTxType:
+		want = 12
+	case BlobTxType:
+		want = 14
+	case SetCodeTxType:
+		want = 13
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", txType)
+	}
+	fields := item.List
+	if len(fields) != want {
+		return nil, fmt.Errorf("invalid type %d transaction: expected %d fields, got %d", txType, want, len(fields))
+	}
+
+	tx := &Transaction{Hash: hashOf(append([]byte{txType}, body...))}
+	txTypeQ := QuantityFromUint64(uint64(txType))
+	tx.Type = &txTypeQ
+	chainID := quantityOf(fields[0])
+	tx.ChainID = &chainID
+
+	var gas, to, value, data, accessList rlp.Item
+	switch txType {
+	case AccessListTxType:
+		gasPrice := quantityOf(fields[2])
+		tx.GasPrice = &gasPrice
+		gas, to, value, data, accessList = fields[3], fields[4], fields[5], fields[6], fields[7]
+	default:
+		maxPriority, maxFee := quantityOf(fields[2]), quantityOf(fields[3])
+		tx.MaxPriorityFeePerGas = &maxPriority
+		tx.MaxFeePerGas = &maxFee
+		gas, to, value, data, accessList = fields[4], fields[5], fields[6], fields[7], fields[8]
+	}
+	if err := setCommonFields(tx, fields[1], gas, to, value, data); err != nil {
+		return nil, err
+	}
+
+	list, err := decodeAccessList(accessList)
+	if err != nil {
+		return nil, err
+	}
+	tx.AccessList = list
+
+	if txType == BlobTxType {
+		maxFeePerBlobGas := quantityOf(fields[9])
+		tx.MaxFeePerBlobGas = &maxFeePerBlobGas
+		if !fields[10].IsList {
+			return nil, fmt.Errorf("invalid blob versioned hashes")
+		}
+		for _, h := range fields[10].List {
+			if h.IsList || len(h.Bytes) != 32 {
+				return nil, fmt.Errorf("invalid blob versioned hash")
+			}
+			tx.BlobVersionedHashes = append(tx.BlobVersionedHashes, Hash(hex.Encode(h.Bytes)))
+		}
+	}
+
+	if txType == SetCodeTxType {
+		auths, err := decodeAuthorizationList(fields[9])
+		if err != nil {
+			return nil, err
+		}
+		tx.AuthorizationList = auths
+	}
+
+	sig := fields[want-3:]
+	yParity := quantityOf(sig[0])
+	tx.YParity = &yParity
+	tx.V, tx.R, tx.S = yParity, quantityOf(sig[1]), quantityOf(sig[2])
+	return tx, nil
+}
+
+// decodeTxFields decodes an RLP list of exactly n byte strings.
+func decodeTxFields(raw []byte, n int) ([]rlp.Item, error) {
+	item, err := rlp.Decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction encoding: %w", err)
+	}
+	if !item.IsList || len(item.List) != n {
+		return nil, fmt.Errorf("invalid legacy transaction: expected %d fields", n)
+	}
+	return item.List, nil
+}
+
+// setCommonFields sets the fields shared by every transaction type.
+func setCommonFields(tx *Transaction, nonce, gas, to, value, data rlp.Item) error {
+	for _, item := range []rlp.Item{nonce, gas, to, value, data} {
+		if item.IsList {
+			return fmt.Errorf("invalid transaction: unexpected list field")
+		}
+	}
+
+	tx.Nonce = quantityOf(nonce)
+	tx.Gas = quantityOf(gas)
+	tx.Value = quantityOf(value)
+	tx.Input = DataFromBytes(data.Bytes)
+
+	switch len(to.Bytes) {
+	case 0:
+		// Contract creation.
+	case 20:
+		addr := Address(hex.Encode(to.Bytes))
+		tx.To = &addr
+	default:
+		return fmt.Errorf("invalid recipient address length %d", len(to.Bytes))
+	}
+	return nil
+}
+
+// decodeAccessList decodes [[address, [key, ...]], ...].
+func decodeAccessList(item rlp.Item) ([]AccessListEntry, error) {
+	if !item.IsList {
+		return nil, fmt.Errorf("invalid access list")
+	}
+	list := make([]AccessListEntry, 0, len(item.List))
+	for _, e := range item.List {
+		if !e.IsList || len(e.List) != 2 || len(e.List[0].Bytes) != 20 || !e.List[1].IsList {
+			return nil, fmt.Errorf("invalid access list entry")
+		}
+		entry := AccessListEntry{
+			Address:     Address(hex.Encode(e.List[0].Bytes)),
+			StorageKeys: make([]Hash, 0, len(e.List[1].List)),
+		}
+		for _, k := range e.List[1].List {
+			if k.IsList || len(k.Bytes) != 32 {
+				return nil, fmt.Errorf("invalid access list storage key")
+			}
+			entry.StorageKeys = append(entry.StorageKeys, Hash(hex.Encode(k.Bytes)))
+		}
+		list = append(list, entry)
+	}
+	return list, nil
+}
+
+// quantityOf converts an RLP integer to a Quantity.
+func quantityOf(item rlp.Item) Quantity {
+	return QuantityFromBigInt(new(big.Int).SetBytes(item.Bytes))
+}
+
+// hashOf returns the Keccak-256 hash of b.
+func hashOf(b []byte) Hash {
+	h := keccak.Sum256(b)
+	return Hash(hex.Encode(h[:]))
+}
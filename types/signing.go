@@ -0,0 +1,208 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/keccak"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/rlp"
+	"github.com/ABT-Tech-Limited/alchemy-go/internal/secp256k1"
+)
+
+// Transaction types.
+const (
+	LegacyTxType     = 0
+	AccessListTxType = 1
+	DynamicFeeTxType = 2
+	BlobTxType       = 3
+	SetCodeTxType    = 4
+)
+
+// Signature returns the transaction's signature values.
+// For typed transactions v is the y-parity (0 or 1); for legacy
+// transactions it is the raw V value (27/28, or EIP-155 encoded).
+func (tx *Transaction) Signature() (r, s *big.Int, v uint64) {
+	v = tx.V.Uint64()
+	if tx.TxType() != LegacyTxType && tx.YParity != nil {
+		v = tx.YParity.Uint64()
+	}
+	return tx.R.BigInt(), tx.S.BigInt(), v
+}
+
+// SigningHash returns the hash the sender signed, derived per transaction type.
+func (tx *Transaction) SigningHash() (Hash, error) {
+	payload, err := tx.signingPayload()
+	if err != nil {
+		return "", err
+	}
+	h := keccak.Sum256(payload)
+	return Hash(hex.Encode(h[:])), nil
+}
+
+// RecoverSender recovers the sender address from the signature.
+func (tx *Transaction) RecoverSender() (Address, error) {
+	hash, err := tx.SigningHash()
+	if err != nil {
+		return "", err
+	}
+
+	r, s, _ := tx.Signature()
+	recID, err := tx.recoveryID()
+	if err != nil {
+		return "", err
+	}
+
+	pub, err := secp256k1.RecoverPubkey(hash.Bytes(), r, s, recID, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover sender: %w", err)
+	}
+	h := keccak.Sum256(pub)
+	return Address(hex.Encode(h[12:])), nil
+}
+
+// VerifySender recovers the sender and checks that it matches From.
+func (tx *Transaction) VerifySender() error {
+	sender, err := tx.RecoverSender()
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(sender.String(), tx.From.String()) {
+		return fmt.Errorf("recovered sender %s does not match from address %s", sender, tx.From)
+	}
+	return nil
+}
+
+// recoveryID returns the secp256k1 recovery id (0 or 1) of the signature.
+func (tx *Transaction) recoveryID() (byte, error) {
+	_, _, v := tx.Signature()
+	if tx.TxType() != LegacyTxType {
+		if v > 1 {
+			return 0, fmt.Errorf("invalid y-parity %d", v)
+		}
+		return byte(v), nil
+	}
+
+	switch {
+	case v == 27 || v == 28:
+		return byte(v - 27), nil
+	case v >= 35:
+		return byte((v - 35) % 2), nil
+	default:
+		return 0, fmt.Errorf("invalid signature v value %d", v)
+	}
+}
+
+// legacyChainID returns the EIP-155 chain ID of a legacy transaction, or nil
+// if the transaction is not replay-protected.
+func (tx *Transaction) legacyChainID() *big.Int {
+	v := tx.V.BigInt()
+	if v.Cmp(big.NewInt(35)) < 0 {
+		return nil
+	}
+	if tx.ChainID != nil {
+		return tx.ChainID.BigInt()
+	}
+	id := new(big.Int).Sub(v, big.NewInt(35))
+	return id.Rsh(id, 1)
+}
+
+// signingPayload returns the RLP payload whose hash is signed.
+func (tx *Transaction) signingPayload() ([]byte, error) {
+	to := rlp.Bytes(nil)
+	if tx.To != nil {
+		to = rlp.Bytes(tx.To.Bytes())
+	}
+	value := rlp.Uint(tx.Value.BigInt())
+	gas := rlp.Uint(tx.Gas.BigInt())
+	nonce := rlp.Uint(tx.Nonce.BigInt())
+	input := rlp.Bytes(tx.Input.Bytes())
+
+	switch tx.TxType() {
+	case LegacyTxType:
+		fields := [][]byte{nonce, rlp.Uint(quantityBigInt(tx.GasPrice)), gas, to, value, input}
+		if chainID := tx.legacyChainID(); chainID != nil {
+			fields = append(fields, rlp.Uint(chainID), rlp.Uint64(0), rlp.Uint64(0))
+		}
+		return rlp.List(fields...), nil
+
+	case AccessListTxType:
+		chainID, err := tx.requireChainID()
+		if err != nil {
+			return nil, err
+		}
+		return typedPayload(AccessListTxType,
+			chainID, nonce, rlp.Uint(quantityBigInt(tx.GasPrice)), gas, to, value, input,
+			encodeAccessList(tx.AccessList)), nil
+
+	case DynamicFeeTxType:
+		chainID, err := tx.requireChainID()
+		if err != nil {
+			return nil, err
+		}
+		return typedPayload(DynamicFeeTxType,
+			chainID, nonce, rlp.Uint(quantityBigInt(tx.MaxPriorityFeePerGas)), rlp.Uint(quantityBigInt(tx.MaxFeePerGas)),
+			gas, to, value, input, encodeAccessList(tx.AccessList)), nil
+
+	case BlobTxType:
+		chainID, err := tx.requireChainID()
+		if err != nil {
+			return nil, err
+		}
+		hashes := make([][]byte, len(tx.BlobVersionedHashes))
+		for i, h := range tx.BlobVersionedHashes {
+			hashes[i] = rlp.Bytes(h.Bytes())
+		}
+		return typedPayload(BlobTxType,
+			chainID, nonce, rlp.Uint(quantityBigInt(tx.MaxPriorityFeePerGas)), rlp.Uint(quantityBigInt(tx.MaxFeePerGas)),
+			gas, to, value, input, encodeAccessList(tx.AccessList),
+			rlp.Uint(quantityBigInt(tx.MaxFeePerBlobGas)), rlp.List(hashes...)), nil
+
+	case SetCodeTxType:
+		chainID, err := tx.requireChainID()
+		if err != nil {
+			return nil, err
+		}
+		return typedPayload(SetCodeTxType,
+			chainID, nonce, rlp.Uint(quantityBigInt(tx.MaxPriorityFeePerGas)), rlp.Uint(quantityBigInt(tx.MaxFeePerGas)),
+			gas, to, value, input, encodeAccessList(tx.AccessList), encodeAuthorizationList(tx.AuthorizationList)), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.TxType())
+	}
+}
+
+// requireChainID returns the RLP-encoded chain ID of a typed transaction.
+func (tx *Transaction) requireChainID() ([]byte, error) {
+	if tx.ChainID == nil {
+		return nil, fmt.Errorf("typed transaction is missing chainId")
+	}
+	return rlp.Uint(tx.ChainID.BigInt()), nil
+}
+
+// typedPayload returns txType || rlp(fields).
+func typedPayload(txType byte, fields ...[]byte) []byte {
+	return append([]byte{txType}, rlp.List(fields...)...)
+}
+
+// encodeAccessList RLP-encodes an access list.
+func encodeAccessList(list []AccessListEntry) []byte {
+	entries := make([][]byte, len(list))
+	for i, entry := range list {
+		keys := make([][]byte, len(entry.StorageKeys))
+		for j, k := range entry.StorageKeys {
+			keys[j] = rlp.Bytes(k.Bytes())
+		}
+		entries[i] = rlp.List(rlp.Bytes(entry.Address.Bytes()), rlp.List(keys...))
+	}
+	return rlp.List(entries...)
+}
+
+// quantityBigInt returns the value of an optional quantity, or zero.
+func quantityBigInt(q *Quantity) *big.Int {
+	if q == nil {
+		return new(big.Int)
+	}
+	return q.BigInt()
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// Signed transactions with known senders. eip155 is the example from
+// EIP-155; the others are signed with the same key (0x4646...46) except
+// eip1559Create, signed with 0x0123456789abcdef repeated.
+const (
+	eip155Tx = `{"nonce":"0x9","gasPrice":"0x4a817c800","gas":"0x5208",
+		"to":"0x3535353535353535353535353535353535353535","value":"0xde0b6b3a7640000","input":"0x",
+		"v":"0x25","r":"0x28ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276",
+		"s":"0x67cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83",
+		"from":"0x9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f"}`
+
+	eip2930Tx = `{"type":"0x1","chainId":"0x1","nonce":"0x3","gasPrice":"0x6fc23ac00","gas":"0xea60",
+		"to":"0x3535353535353535353535353535353535353535","value":"0x0",
+		"input":"0xa9059cbb0000000000000000000000003535353535353535353535353535353535353535000000000000000000000000000000000000000000000000000000000000000a",
+		"accessList":[{"address":"0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae","storageKeys":[
+			"0x0000000000000000000000000000000000000000000000000000000000000003",
+			"0x0000000000000000000000000000000000000000000000000000000000000007"]}],
+		"v":"0x1","yParity":"0x1","r":"0xcba2996b9752466d3471de842cd2663cc70a25d5391a8c79e08de8e935d6e560",
+		"s":"0x2d097e6170c0ac258ba582757e272dfbd63dce68978829c9d68b49b2293d9b51",
+		"from":"0x9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f"}`
+
+	eip1559Tx = `{"type":"0x2","chainId":"0x1","nonce":"0x4","maxPriorityFeePerGas":"0x77359400",
+		"maxFeePerGas":"0xba43b7400","gas":"0x5208","to":"0x3535353535353535353535353535353535353535",
+		"value":"0x16345785d8a0000","input":"0x","accessList":[],
+		"v":"0x1","yParity":"0x1","r":"0x7a8cf5a87e0a8b171dd8dced34c872daaf6d417801d14df3cc886a906d86a6eb",
+		"s":"0x42c2c4dc84b07da67a8dac6cd3eb136df41d0945def64b59d6126c1073ea16d1",
+		"from":"0x9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f"}`
+
+	eip1559CreateTx = `{"type":"0x2","chainId":"0x89","nonce":"0x0","maxPriorityFeePerGas":"0x6fc23ac00",
+		"maxFeePerGas":"0x174876e800","gas":"0x186a0","to":null,"value":"0x0","input":"0x6080604052",
+		"accessList":[{"address":"0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae","storageKeys":[
+			"0x0000000000000000000000000000000000000000000000000000000000000003",
+			"0x0000000000000000000000000000000000000000000000000000000000000007"]}],
+		"v":"0x1","yParity":"0x1","r":"0x425f3da439fe501c3b2ae5c8a2f79cbfe4f9217ff07f0c5f3b5ca47d423ffc2d",
+		"s":"0x125c5a48848fc0eda6b9b32fcecc09a9ba49169f0f1a66cda07eb6822c2db422",
+		"from":"0xfcad0b19bb29d4674531d6f115237e16afce377c"}`
+)
+
+// parseTx decodes a transaction as returned by eth_getTransactionByHash.
+func parseTx(t *testing.T, s string) *Transaction {
+	t.Helper()
+	var tx Transaction
+	if err := json.Unmarshal([]byte(s), &tx); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	return &tx
+}
+
+func TestRecoverSender(t *testing.T) {
+	tests := []struct {
+		name   string
+		tx     string
+		hash   Hash
+		sender string
+	}{
+		{"EIP-155", eip155Tx,
+			"0xdaf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53",
+			"0x9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f"},
+		{"EIP-2930", eip2930Tx,
+			"0xb6a283ed05f97e2f70d6913fea13425cf9fe406ab5fc5cab18c034166969f7b3",
+			"0x9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f"},
+		{"EIP-1559", eip1559Tx,
+			"0x3d0156aa9b71d7a8d31503dba5f460d5fc495359828d2f714aac05c1457e7107",
+			"0x9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f"},
+		{"EIP-1559 contract creation", eip1559CreateTx,
+			"0xe9181a2e31cb685c3f12c25d0f96538c52f098e2e3f2e3f26c2eb933213485b1",
+			"0xfcad0b19bb29d4674531d6f115237e16afce377c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := parseTx(t, tt.tx)
+
+			hash, err := tx.SigningHash()
+			if err != nil {
+				t.Fatalf("SigningHash() error = %v", err)
+			}
+			if hash != tt.hash {
+				t.Errorf("SigningHash() = %s, want %s", hash, tt.hash)
+			}
+
+			sender, err := tx.RecoverSender()
+			if err != nil {
+				t.Fatalf("RecoverSender() error = %v", err)
+			}
+			if !strings.EqualFold(sender.String(), tt.sender) {
+				t.Errorf("RecoverSender() = %s, want %s", sender, tt.sender)
+			}
+			if err := tx.VerifySender(); err != nil {
+				t.Errorf("VerifySender() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestRecoverSenderRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		tx     string
+		modify func(tx *Transaction)
+	}{
+		{"high s", eip1559Tx, func(tx *Transaction) {
+			// N - s, the malleable twin of the signature.
+			tx.S = "0xbd3d3b237b4f8259857253932c14ec90c691d3a0d05254e1e9bff27c5c4c2a70"
+			parity := Quantity("0x0")
+			tx.YParity = &parity
+		}},
+		{"y-parity 2", eip1559Tx, func(tx *Transaction) {
+			parity := Quantity("0x2")
+			tx.YParity = &parity
+		}},
+		{"legacy v 29", eip155Tx, func(tx *Transaction) { tx.V = "0x1d" }},
+		{"zero r", eip2930Tx, func(tx *Transaction) { tx.R = "0x0" }},
+		{"typed without chain ID", eip1559Tx, func(tx *Transaction) { tx.ChainID = nil }},
+		{"unsupported type", eip1559Tx, func(tx *Transaction) {
+			txType := Quantity("0x7f")
+			tx.Type = &txType
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := parseTx(t, tt.tx)
+			tt.modify(tx)
+			if sender, err := tx.RecoverSender(); err == nil {
+				t.Errorf("RecoverSender() = %s, want error", sender)
+			}
+		})
+	}
+}
+
+func TestVerifySenderMismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(tx *Transaction)
+	}{
+		{"tampered value", func(tx *Transaction) { tx.Value = "0x1" }},
+		{"wrong from", func(tx *Transaction) { tx.From = "0x3535353535353535353535353535353535353535" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := parseTx(t, eip1559Tx)
+			tt.modify(tx)
+			if err := tx.VerifySender(); err == nil {
+				t.Error("VerifySender() error = nil, want mismatch")
+			}
+		})
+	}
+}
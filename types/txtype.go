@@ -0,0 +1,35 @@
+package types
+
+import "fmt"
+
+// TxType is an EIP-2718 transaction type.
+type TxType uint8
+
+// Transaction types by name.
+const (
+	TxTypeLegacy     TxType = LegacyTxType
+	TxTypeAccessList TxType = AccessListTxType
+	TxTypeDynamicFee TxType = DynamicFeeTxType
+	TxTypeBlob       TxType = BlobTxType
+	TxTypeSetCode    TxType = SetCodeTxType
+)
+
+// String returns the canonical name of the type: "legacy", "access-list",
+// "dynamic-fee", "blob" or "setcode". Unknown types are rendered as
+// "unknown(N)".
+func (t TxType) String() string {
+	switch t {
+	case TxTypeLegacy:
+		return "legacy"
+	case TxTypeAccessList:
+		return "access-list"
+	case TxTypeDynamicFee:
+		return "dynamic-fee"
+	case TxTypeBlob:
+		return "blob"
+	case TxTypeSetCode:
+		return "setcode"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
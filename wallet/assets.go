@@ -25,6 +25,10 @@ type NFTQueryOptions struct {
 	ContractAddresses []types.Address
 	// ExcludeSpam excludes spam NFTs.
 	ExcludeSpam bool
+	// SpamConfidence is the spam detection threshold, e.g.
+	// data.SpamConfidenceVeryHigh to exclude only the most certain spam.
+	// Setting it implies ExcludeSpam.
+	SpamConfidence data.SpamConfidenceLevel
 	// ExcludeAirdrops excludes airdropped NFTs.
 	ExcludeAirdrops bool
 	// WithMetadata includes NFT metadata.
@@ -42,21 +46,18 @@ func DefaultNFTQueryOptions() *NFTQueryOptions {
 	}
 }
 
-// GetNFTs retrieves NFTs owned by an address.
-func (c *Client) GetNFTs(ctx context.Context, address types.Address, options *NFTQueryOptions) (*NFTsResult, error) {
-	if options == nil {
-		options = DefaultNFTQueryOptions()
-	}
-
+// nftsForOwnerParams builds getNFTsForOwner parameters from query options.
+func nftsForOwnerParams(address types.Address, options *NFTQueryOptions) *data.NFTsForOwnerParams {
 	params := data.NewNFTsForOwnerParams(address)
 
 	if len(options.ContractAddresses) > 0 {
 		params.SetContractAddresses(options.ContractAddresses)
 	}
 
-	if options.ExcludeSpam || options.ExcludeAirdrops {
+	excludeSpam := options.ExcludeSpam || options.SpamConfidence != ""
+	if excludeSpam || options.ExcludeAirdrops {
 		var filters []data.NFTFilter
-		if options.ExcludeSpam {
+		if excludeSpam {
 			filters = append(filters, data.NFTFilterSpam)
 		}
 		if options.ExcludeAirdrops {
@@ -65,12 +66,27 @@ func (c *Client) GetNFTs(ctx context.Context, address types.Address, options *NF
 		params.SetExcludeFilters(filters)
 	}
 
+	if options.SpamConfidence != "" {
+		params.SetSpamConfidenceLevel(options.SpamConfidence)
+	}
+
 	params.SetWithMetadata(options.WithMetadata)
 
 	if options.PageSize > 0 {
 		params.SetPageSize(options.PageSize)
 	}
 
+	return params
+}
+
+// GetNFTs retrieves NFTs owned by an address.
+func (c *Client) GetNFTs(ctx context.Context, address types.Address, options *NFTQueryOptions) (*NFTsResult, error) {
+	if options == nil {
+		options = DefaultNFTQueryOptions()
+	}
+
+	params := nftsForOwnerParams(address, options)
+
 	resp, err := c.data.GetNFTsForOwner(ctx, params)
 	if err != nil {
 		return nil, err
@@ -93,28 +109,7 @@ func (c *Client) GetAllNFTs(ctx context.Context, address types.Address, options
 	var allNFTs []data.OwnedNFT
 	totalCount := 0
 
-	params := data.NewNFTsForOwnerParams(address)
-
-	if len(options.ContractAddresses) > 0 {
-		params.SetContractAddresses(options.ContractAddresses)
-	}
-
-	if options.ExcludeSpam || options.ExcludeAirdrops {
-		var filters []data.NFTFilter
-		if options.ExcludeSpam {
-			filters = append(filters, data.NFTFilterSpam)
-		}
-		if options.ExcludeAirdrops {
-			filters = append(filters, data.NFTFilterAirdrops)
-		}
-		params.SetExcludeFilters(filters)
-	}
-
-	params.SetWithMetadata(options.WithMetadata)
-
-	if options.PageSize > 0 {
-		params.SetPageSize(options.PageSize)
-	}
+	params := nftsForOwnerParams(address, options)
 
 	for {
 		resp, err := c.data.GetNFTsForOwner(ctx, params)
@@ -139,37 +134,15 @@ func (c *Client) GetAllNFTs(ctx context.Context, address types.Address, options
 }
 
 // GetERC721Assets retrieves ERC721 NFTs owned by an address.
+// Only the owner's ERC721 contracts are queried (see data.GetNFTsForOwnerByType).
 func (c *Client) GetERC721Assets(ctx context.Context, address types.Address) ([]data.OwnedNFT, error) {
-	result, err := c.GetAllNFTs(ctx, address, DefaultNFTQueryOptions())
-	if err != nil {
-		return nil, err
-	}
-
-	var erc721s []data.OwnedNFT
-	for _, nft := range result.NFTs {
-		if nft.TokenType == string(data.NFTTokenTypeERC721) || nft.Contract.TokenType == string(data.NFTTokenTypeERC721) {
-			erc721s = append(erc721s, nft)
-		}
-	}
-
-	return erc721s, nil
+	return c.data.GetNFTsForOwnerByType(ctx, nftsForOwnerParams(address, DefaultNFTQueryOptions()), data.NFTTokenTypeERC721)
 }
 
 // GetERC1155Assets retrieves ERC1155 NFTs owned by an address.
+// Only the owner's ERC1155 contracts are queried (see data.GetNFTsForOwnerByType).
 func (c *Client) GetERC1155Assets(ctx context.Context, address types.Address) ([]data.OwnedNFT, error) {
-	result, err := c.GetAllNFTs(ctx, address, DefaultNFTQueryOptions())
-	if err != nil {
-		return nil, err
-	}
-
-	var erc1155s []data.OwnedNFT
-	for _, nft := range result.NFTs {
-		if nft.TokenType == string(data.NFTTokenTypeERC1155) || nft.Contract.TokenType == string(data.NFTTokenTypeERC1155) {
-			erc1155s = append(erc1155s, nft)
-		}
-	}
-
-	return erc1155s, nil
+	return c.data.GetNFTsForOwnerByType(ctx, nftsForOwnerParams(address, DefaultNFTQueryOptions()), data.NFTTokenTypeERC1155)
 }
 
 // AssetSummary provides a summary of all assets owned by an address.
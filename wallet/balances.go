@@ -3,6 +3,7 @@ package wallet
 import (
 	"context"
 	"math/big"
+	"sync"
 
 	"github.com/ABT-Tech-Limited/alchemy-go/data"
 	"github.com/ABT-Tech-Limited/alchemy-go/internal/hex"
@@ -93,25 +94,31 @@ func (c *Client) GetTokenBalances(ctx context.Context, address types.Address, co
 	}
 
 	for _, tb := range resp.TokenBalances {
-		info := TokenBalanceInfo{
-			ContractAddress: tb.ContractAddress,
-		}
-
-		if tb.Error != nil {
-			info.Error = *tb.Error
-		} else if tb.TokenBalance != nil {
-			balance, _ := hex.DecodeBigInt(*tb.TokenBalance)
-			info.Balance = balance
-		}
-
-		result.Balances = append(result.Balances, info)
+		result.Balances = append(result.Balances, tokenBalanceInfo(tb))
 	}
 
 	return result, nil
 }
 
+// TokenMetadataOptions configures how token metadata is resolved.
+type TokenMetadataOptions struct {
+	// OnChainFallback reads missing name, symbol and decimals directly from
+	// the token contract. It costs up to three extra eth_calls per token.
+	OnChainFallback bool
+}
+
 // GetTokenBalancesWithMetadata retrieves token balances with metadata.
 func (c *Client) GetTokenBalancesWithMetadata(ctx context.Context, address types.Address, contractAddresses []types.Address) (*TokenBalancesResult, error) {
+	return c.GetTokenBalancesWithMetadataOptions(ctx, address, contractAddresses, nil)
+}
+
+// GetTokenBalancesWithMetadataOptions retrieves token balances with metadata
+// resolved according to opts. A nil opts behaves like GetTokenBalancesWithMetadata.
+func (c *Client) GetTokenBalancesWithMetadataOptions(ctx context.Context, address types.Address, contractAddresses []types.Address, opts *TokenMetadataOptions) (*TokenBalancesResult, error) {
+	if opts == nil {
+		opts = &TokenMetadataOptions{}
+	}
+
 	result, err := c.GetTokenBalances(ctx, address, contractAddresses)
 	if err != nil {
 		return nil, err
@@ -123,7 +130,12 @@ func (c *Client) GetTokenBalancesWithMetadata(ctx context.Context, address types
 			continue
 		}
 
-		metadata, err := c.data.GetTokenMetadata(ctx, result.Balances[i].ContractAddress)
+		var metadata *data.TokenMetadata
+		if opts.OnChainFallback {
+			metadata, err = c.data.GetTokenMetadataWithFallback(ctx, result.Balances[i].ContractAddress)
+		} else {
+			metadata, err = c.data.GetTokenMetadata(ctx, result.Balances[i].ContractAddress)
+		}
 		if err != nil {
 			continue // Ignore metadata errors
 		}
@@ -141,48 +153,80 @@ func (c *Client) GetTokenBalancesWithMetadata(ctx context.Context, address types
 
 // GetAllTokenBalances retrieves all ERC20 token balances with pagination.
 func (c *Client) GetAllTokenBalances(ctx context.Context, address types.Address) (*TokenBalancesResult, error) {
-	var allBalances []TokenBalanceInfo
-	pageKey := ""
-
-	for {
-		params := data.NewTokenBalancesParams(address).
-			SetTokenSpec(data.TokenSpecERC20)
-		if pageKey != "" {
-			params.PageKey = pageKey
-		}
+	params := data.NewTokenBalancesParams(address).
+		SetTokenSpec(data.TokenSpecERC20)
 
-		resp, err := c.data.GetTokenBalances(ctx, params)
-		if err != nil {
-			return nil, err
-		}
+	balances, err := c.data.GetTokenBalancesIterator(ctx, params).Collect()
+	if err != nil {
+		return nil, err
+	}
 
-		for _, tb := range resp.TokenBalances {
-			info := TokenBalanceInfo{
-				ContractAddress: tb.ContractAddress,
-			}
+	result := &TokenBalancesResult{
+		Address:  address,
+		Balances: make([]TokenBalanceInfo, 0, len(balances)),
+	}
+	for _, tb := range balances {
+		result.Balances = append(result.Balances, tokenBalanceInfo(tb))
+	}
 
-			if tb.Error != nil {
-				info.Error = *tb.Error
-			} else if tb.TokenBalance != nil {
-				balance, _ := hex.DecodeBigInt(*tb.TokenBalance)
-				info.Balance = balance
-			}
+	return result, nil
+}
 
-			allBalances = append(allBalances, info)
-		}
+// AllBalances holds the native balance and all ERC20 balances of an address.
+type AllBalances struct {
+	// Address is the wallet address.
+	Address types.Address
+	// Native is the native token balance.
+	Native *Balance
+	// Tokens holds all ERC20 token balances.
+	Tokens *TokenBalancesResult
+}
 
-		if !resp.HasMore() {
-			break
-		}
-		pageKey = resp.PageKey
+// GetAllBalances retrieves the native balance and all ERC20 token balances
+// of an address. The native balance is fetched concurrently with the token
+// scan.
+func (c *Client) GetAllBalances(ctx context.Context, address types.Address) (*AllBalances, error) {
+	var native *Balance
+	var nativeErr error
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		native, nativeErr = c.GetBalance(ctx, address)
+	}()
+
+	tokens, err := c.GetAllTokenBalances(ctx, address)
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	if nativeErr != nil {
+		return nil, nativeErr
 	}
 
-	return &TokenBalancesResult{
-		Address:  address,
-		Balances: allBalances,
+	return &AllBalances{
+		Address: address,
+		Native:  native,
+		Tokens:  tokens,
 	}, nil
 }
 
+// tokenBalanceInfo converts a raw token balance to a TokenBalanceInfo.
+func tokenBalanceInfo(tb data.TokenBalance) TokenBalanceInfo {
+	info := TokenBalanceInfo{
+		ContractAddress: tb.ContractAddress,
+	}
+
+	if tb.Error != nil {
+		info.Error = *tb.Error
+	} else if tb.TokenBalance != nil {
+		balance, _ := hex.DecodeBigInt(*tb.TokenBalance)
+		info.Balance = balance
+	}
+
+	return info
+}
+
 // formatWei formats a wei value as ETH.
 func formatWei(wei *big.Int) string {
 	if wei == nil {
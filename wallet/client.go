@@ -7,6 +7,7 @@ import (
 )
 
 // Client provides wallet-related operations.
+// It is safe for concurrent use by multiple goroutines.
 type Client struct {
 	data *data.Client
 	node *node.Client
@@ -0,0 +1,84 @@
+package wallet
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/node"
+	"github.com/ABT-Tech-Limited/alchemy-go/types"
+)
+
+// NonceManager hands out sequential nonces for an address so several
+// transactions can be sent without waiting for each to reach the mempool.
+// The first nonce is taken from the pending transaction count; after that
+// nonces are tracked locally until Reset resyncs with the chain.
+// A NonceManager is safe for concurrent use.
+type NonceManager struct {
+	node    *node.Client
+	address types.Address
+
+	mu     sync.Mutex
+	next   uint64
+	synced bool
+}
+
+// NewNonceManager creates a nonce manager for address.
+func (c *Client) NewNonceManager(address types.Address) *NonceManager {
+	return &NonceManager{
+		node:    c.node,
+		address: address,
+	}
+}
+
+// Address returns the managed address.
+func (m *NonceManager) Address() types.Address {
+	return m.address
+}
+
+// Next returns the next nonce to use and reserves it.
+func (m *NonceManager) Next(ctx context.Context) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		if err := m.sync(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	nonce := m.next
+	m.next++
+	return nonce, nil
+}
+
+// Release returns a nonce reserved by Next whose transaction was never
+// broadcast. It only takes effect for the most recently reserved nonce;
+// otherwise the gap can only be fixed with Reset.
+func (m *NonceManager) Release(nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.synced && nonce+1 == m.next {
+		m.next = nonce
+	}
+}
+
+// Reset resyncs the next nonce from the pending transaction count.
+// Call it after a send fails with "nonce too low" or when transactions
+// were sent from the address by other means.
+func (m *NonceManager) Reset(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sync(ctx)
+}
+
+// sync fetches the pending nonce. The caller must hold m.mu.
+func (m *NonceManager) sync(ctx context.Context) error {
+	nonce, err := m.node.GetTransactionCount(ctx, m.address, node.BlockPending)
+	if err != nil {
+		return err
+	}
+	m.next = nonce
+	m.synced = true
+	return nil
+}
@@ -0,0 +1,96 @@
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/data"
+	"github.com/ABT-Tech-Limited/alchemy-go/prices"
+)
+
+// PricedBalance is a token balance with its US dollar valuation.
+type PricedBalance struct {
+	TokenBalanceInfo
+	// PriceUSD is the token price in US dollars, if known.
+	PriceUSD *float64
+	// ValueUSD is the balance value in US dollars. It requires both a price
+	// and token decimals (see GetTokenBalancesWithMetadata).
+	ValueUSD *float64
+}
+
+// PricedBalances is a priced breakdown of a TokenBalancesResult.
+type PricedBalances struct {
+	// Network is the network the balances were priced on.
+	Network string
+	// Balances is the list of priced balances, in the original order.
+	Balances []PricedBalance
+	// TotalUSD is the sum of all known ValueUSD amounts.
+	TotalUSD float64
+}
+
+// PriceTokenBalances values token balances with the Prices API.
+// network is the network identifier the balances were fetched on
+// (e.g. alchemy.EthMainnet.String()). Balances with an error are kept
+// but left unpriced.
+func PriceTokenBalances(ctx context.Context, pricesClient *prices.Client, network string, result *TokenBalancesResult) (*PricedBalances, error) {
+	priced := &PricedBalances{
+		Network:  network,
+		Balances: make([]PricedBalance, len(result.Balances)),
+	}
+
+	var refs []prices.TokenAddressRef
+	var indexes []int
+	for i, b := range result.Balances {
+		priced.Balances[i] = PricedBalance{TokenBalanceInfo: b}
+		if b.Error != "" {
+			continue
+		}
+		refs = append(refs, prices.TokenAddressRef{Network: network, Address: b.ContractAddress})
+		indexes = append(indexes, i)
+	}
+
+	for start := 0; start < len(refs); start += prices.MaxAddressesPerRequest {
+		end := start + prices.MaxAddressesPerRequest
+		if end > len(refs) {
+			end = len(refs)
+		}
+
+		tokenPrices, err := pricesClient.GetTokenPricesByAddress(ctx, refs[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		for j, tp := range tokenPrices {
+			price, ok := tp.PriceInUSD()
+			if !ok {
+				continue
+			}
+			pb := &priced.Balances[indexes[start+j]]
+			pb.PriceUSD = &price
+
+			value, ok := tokenValue(pb.Balance, pb.Metadata, price)
+			if !ok {
+				continue
+			}
+			pb.ValueUSD = &value
+			priced.TotalUSD += value
+		}
+	}
+
+	return priced, nil
+}
+
+// tokenValue returns balance * price, scaled by the token's decimals.
+func tokenValue(balance *big.Int, metadata *data.TokenMetadata, price float64) (float64, bool) {
+	if balance == nil || metadata == nil || metadata.Decimals == nil {
+		return 0, false
+	}
+
+	amount := new(big.Float).SetInt(balance)
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(*metadata.Decimals)), nil))
+	amount.Quo(amount, scale)
+	amount.Mul(amount, big.NewFloat(price))
+
+	value, _ := amount.Float64()
+	return value, true
+}
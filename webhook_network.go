@@ -0,0 +1,55 @@
+package alchemy
+
+import (
+	"fmt"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/data"
+	"github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+// webhookNetworks maps networks to their Notify (webhook) identifiers.
+// Networks without an entry are not supported by Notify.
+var webhookNetworks = map[Network]data.WebhookNetwork{
+	EthMainnet:      data.WebhookNetworkEthMainnet,
+	EthSepolia:      data.WebhookNetworkEthSepolia,
+	EthHolesky:      data.WebhookNetworkEthHolesky,
+	PolygonMainnet:  data.WebhookNetworkPolygonMainnet,
+	PolygonAmoy:     data.WebhookNetworkPolygonAmoy,
+	ArbitrumMainnet: data.WebhookNetworkArbitrumMainnet,
+	ArbitrumSepolia: data.WebhookNetworkArbitrumSepolia,
+	OptimismMainnet: data.WebhookNetworkOptimismMainnet,
+	OptimismSepolia: data.WebhookNetworkOptimismSepolia,
+	BaseMainnet:     data.WebhookNetworkBaseMainnet,
+	BaseSepolia:     data.WebhookNetworkBaseSepolia,
+	ZkSyncMainnet:   data.WebhookNetworkZkSyncMainnet,
+	ZkSyncSepolia:   data.WebhookNetworkZkSyncSepolia,
+}
+
+// WebhookNetworkFromNetwork returns the webhook network identifier of n,
+// e.g. data.WebhookNetworkEthMainnet for EthMainnet. It lives here rather
+// than in the data package because data cannot import Network.
+// Returns errors.ErrWebhookNetworkUnsupported for networks Notify does not support.
+func WebhookNetworkFromNetwork(n Network) (data.WebhookNetwork, error) {
+	if w, ok := webhookNetworks[n]; ok {
+		return w, nil
+	}
+	return "", fmt.Errorf("%w: %q", errors.ErrWebhookNetworkUnsupported, n)
+}
+
+// NetworkFromWebhookNetwork returns the network of a webhook network
+// identifier, e.g. EthMainnet for data.WebhookNetworkEthMainnet.
+// Returns errors.ErrNetworkNotFound for unknown identifiers.
+func NetworkFromWebhookNetwork(w data.WebhookNetwork) (Network, error) {
+	for n, candidate := range webhookNetworks {
+		if candidate == w {
+			return n, nil
+		}
+	}
+	return "", fmt.Errorf("%w: webhook network %q", errors.ErrNetworkNotFound, w)
+}
+
+// WebhookNetwork returns the webhook network identifier of the network.
+// See WebhookNetworkFromNetwork.
+func (n Network) WebhookNetwork() (data.WebhookNetwork, error) {
+	return WebhookNetworkFromNetwork(n)
+}
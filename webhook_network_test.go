@@ -0,0 +1,44 @@
+package alchemy
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/ABT-Tech-Limited/alchemy-go/data"
+	"github.com/ABT-Tech-Limited/alchemy-go/errors"
+)
+
+func TestWebhookNetworkRoundTrip(t *testing.T) {
+	seen := make(map[data.WebhookNetwork]Network)
+	for _, n := range AllNetworks() {
+		w, err := WebhookNetworkFromNetwork(n)
+		if err != nil {
+			if !stderrors.Is(err, errors.ErrWebhookNetworkUnsupported) {
+				t.Errorf("WebhookNetworkFromNetwork(%s) error = %v, want ErrWebhookNetworkUnsupported", n, err)
+			}
+			continue
+		}
+		if prev, ok := seen[w]; ok {
+			t.Errorf("%s and %s both map to %s", prev, n, w)
+		}
+		seen[w] = n
+
+		back, err := NetworkFromWebhookNetwork(w)
+		if err != nil || back != n {
+			t.Errorf("NetworkFromWebhookNetwork(%s) = %s, %v; want %s", w, back, err, n)
+		}
+		if m, err := n.WebhookNetwork(); err != nil || m != w {
+			t.Errorf("%s.WebhookNetwork() = %s, %v; want %s", n, m, err, w)
+		}
+	}
+
+	if len(seen) != len(webhookNetworks) {
+		t.Errorf("AllNetworks() covers %d webhook networks, want %d", len(seen), len(webhookNetworks))
+	}
+}
+
+func TestNetworkFromWebhookNetworkUnknown(t *testing.T) {
+	if _, err := NetworkFromWebhookNetwork("NOT_A_NETWORK"); !stderrors.Is(err, errors.ErrNetworkNotFound) {
+		t.Errorf("NetworkFromWebhookNetwork() error = %v, want ErrNetworkNotFound", err)
+	}
+}